@@ -2,6 +2,7 @@ package goshopify
 
 import (
 	"fmt"
+	"net/http"
 	"reflect"
 )
 
@@ -9,8 +10,19 @@ const (
 	abandonedCheckoutsBasePath = "checkouts"
 )
 
+// Statuses available to filter abandoned checkouts.
+const (
+	AbandonedCheckoutStatusOpen   = "open"
+	AbandonedCheckoutStatusClosed = "closed"
+)
+
+// AbandonedCheckoutService is an interface for interfacing with the abandoned
+// checkout endpoints of the Shopify API.
+// See: https://shopify.dev/docs/admin-api/rest/reference/orders/abandoned-checkouts
 type AbandonedCheckoutService interface {
 	List(interface{}) ([]Checkout, error)
+	ListWithPagination(interface{}) ([]Checkout, *Pagination, error)
+	Count(interface{}) (int, error)
 	GetOrderList() []string
 }
 
@@ -22,11 +34,48 @@ type AbandonedCheckoutResource struct {
 	Checkouts []Checkout `json:"checkouts"`
 }
 
+// AbandonedCheckoutListOptions represents the options available when listing
+// or counting abandoned checkouts. Status defaults to open.
+type AbandonedCheckoutListOptions struct {
+	ListOptions
+	Status string `url:"status,omitempty"`
+}
+
 // List checkouts
 func (s *AbandonedCheckoutServiceOp) List(options interface{}) ([]Checkout, error) {
 	path := fmt.Sprintf("%s.json", abandonedCheckoutsBasePath)
 	resource := &AbandonedCheckoutResource{}
-	return resource.Checkouts, s.client.Get(path, resource, options)
+	err := s.client.Get(path, resource, options)
+	return resource.Checkouts, err
+}
+
+// ListWithPagination lists a page of abandoned checkouts, see
+// AbandonedCheckoutListOptions
+func (s *AbandonedCheckoutServiceOp) ListWithPagination(options interface{}) ([]Checkout, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", abandonedCheckoutsBasePath)
+	resource := new(AbandonedCheckoutResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Checkouts, pagination, nil
+}
+
+// Count abandoned checkouts, see AbandonedCheckoutListOptions
+func (s *AbandonedCheckoutServiceOp) Count(options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", abandonedCheckoutsBasePath)
+	return s.client.Count(path, options)
 }
 
 func (s *AbandonedCheckoutServiceOp) GetOrderList() []string {
@@ -0,0 +1,86 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestAbandonedCheckoutListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{
+		"status":         "closed",
+		"since_id":       "450789469",
+		"created_at_min": "2021-01-01T00:00:00Z",
+		"limit":          "2",
+	}
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/checkouts.json", client.pathPrefix),
+		params,
+		httpmock.ResponderFromResponse(&http.Response{
+			StatusCode: 200,
+			Body:       httpmock.NewRespBodyFromString(`{"checkouts":[{"id":450789470},{"id":450789471}]}`),
+			Header: http.Header{
+				"Link": {`<http://valid.url?limit=2&page_info=pageInfoCode>; rel="next"`},
+			},
+		}))
+
+	options := AbandonedCheckoutListOptions{
+		ListOptions: ListOptions{
+			Limit:        2,
+			SinceID:      450789469,
+			CreatedAtMin: time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		Status: AbandonedCheckoutStatusClosed,
+	}
+	checkouts, page, err := client.AbandonedCheckout.ListWithPagination(options)
+	if err != nil {
+		t.Fatalf("AbandonedCheckout.ListWithPagination returned error: %v", err)
+	}
+
+	if len(checkouts) != 2 || checkouts[0].ID != 450789470 || checkouts[1].ID != 450789471 {
+		t.Errorf("AbandonedCheckout.ListWithPagination returned %+v, expected checkouts 450789470 and 450789471", checkouts)
+	}
+
+	expectedPage := &ListOptions{PageInfo: "pageInfoCode", Limit: 2}
+	if page == nil || !reflect.DeepEqual(page.NextPageOptions, expectedPage) {
+		t.Errorf("AbandonedCheckout.ListWithPagination returned pagination %+v, expected next page %+v", page, expectedPage)
+	}
+}
+
+func TestAbandonedCheckoutCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/checkouts/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 3}`))
+
+	params := map[string]string{"status": "closed"}
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/checkouts/count.json", client.pathPrefix),
+		params, httpmock.NewStringResponder(200, `{"count": 1}`))
+
+	cnt, err := client.AbandonedCheckout.Count(nil)
+	if err != nil {
+		t.Errorf("AbandonedCheckout.Count returned error: %v", err)
+	}
+
+	expected := 3
+	if cnt != expected {
+		t.Errorf("AbandonedCheckout.Count returned %d, expected %d", cnt, expected)
+	}
+
+	cnt, err = client.AbandonedCheckout.Count(AbandonedCheckoutListOptions{Status: AbandonedCheckoutStatusClosed})
+	if err != nil {
+		t.Errorf("AbandonedCheckout.Count returned error: %v", err)
+	}
+
+	expected = 1
+	if cnt != expected {
+		t.Errorf("AbandonedCheckout.Count returned %d, expected %d", cnt, expected)
+	}
+}
@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -12,14 +13,28 @@ const (
 	articlesAuthorsPath = "authors"
 	articlesTagsPath    = "tags"
 	articlesBlogsPath   = "blogs"
+
+	articlesResourceName = "articles"
 )
 
+// ArticleService is an interface for interacting with the articles
+// endpoints of the Shopify API.
+// See https://shopify.dev/docs/admin-api/rest/reference/online-store/article
 type ArticleService interface {
 	AuthorsList(interface{}) ([]string, error)
 	TagsList(interface{}) ([]string, error)
 	List(int64, interface{}) ([]Article, error)
 	ListWithPagination(int64, interface{}) ([]Article, *Pagination, error)
+	Count(int64, interface{}) (int, error)
+	Get(int64, int64, interface{}) (*Article, error)
+	Create(int64, Article) (*Article, error)
+	Update(int64, Article) (*Article, error)
+	Delete(int64, int64) error
 	GetOrderList() []string
+
+	// MetafieldsService used for Article resource to communicate with Metafields
+	// resource
+	MetafieldsService
 }
 
 // ArticleServiceOp handles communication with the article related methods of
@@ -45,13 +60,50 @@ type Article struct {
 	BlogID            int64      `json:"blog_id"`
 	Author            string     `json:"author"`
 	UserID            int64      `json:"user_id"`
-	PublishedAt       *time.Time `json:"published_at"`
+	PublishedAt       *time.Time `json:"published_at,omitempty"`
 	UpdatedAt         *time.Time `json:"updated_at"`
 	SummaryHTML       string     `json:"summary_html"`
 	TemplateSuffix    string     `json:"template_suffix"`
 	Handle            string     `json:"handle"`
 	Tags              string     `json:"tags"`
 	AdminGraphqlAPIID string     `json:"admin_graphql_api_id"`
+
+	// Published hides the article when set to false and publishes it when
+	// set to true. It is only sent, Shopify returns PublishedAt instead.
+	Published  *bool         `json:"published,omitempty"`
+	Image      *ArticleImage `json:"image,omitempty"`
+	Metafields []Metafield   `json:"metafields,omitempty"`
+}
+
+// ArticleImage represents the image of an article. New images are uploaded
+// either from Src or from a base64 encoded Attachment.
+type ArticleImage struct {
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	Src        string     `json:"src,omitempty"`
+	Alt        string     `json:"alt,omitempty"`
+	Width      int        `json:"width,omitempty"`
+	Height     int        `json:"height,omitempty"`
+	Attachment string     `json:"attachment,omitempty"`
+}
+
+// ArticleListOptions represents the options available when listing or
+// counting the articles of a blog
+type ArticleListOptions struct {
+	ListOptions
+	Handle          string    `url:"handle,omitempty"`
+	Tag             string    `url:"tag,omitempty"`
+	Author          string    `url:"author,omitempty"`
+	PublishedStatus string    `url:"published_status,omitempty"`
+	PublishedAtMin  time.Time `url:"published_at_min,omitempty"`
+	PublishedAtMax  time.Time `url:"published_at_max,omitempty"`
+}
+
+// SetImageAttachment sets the image of the article to be uploaded from data
+func (a *Article) SetImageAttachment(data []byte, alt string) {
+	a.Image = &ArticleImage{
+		Attachment: base64.StdEncoding.EncodeToString(data),
+		Alt:        alt,
+	}
 }
 
 // ArticlesResource is the result from the articles.json endpoint
@@ -106,6 +158,79 @@ func (s *ArticleServiceOp) ListWithPagination(blogID int64, options interface{})
 	return resource.Articles, pagination, nil
 }
 
+// Count articles of a blog
+func (s *ArticleServiceOp) Count(blogID int64, options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/%d/%s/count.json", articlesBlogsPath, blogID, articlesBasePath)
+	return s.client.Count(path, options)
+}
+
+// Get individual article of a blog
+func (s *ArticleServiceOp) Get(blogID int64, articleID int64, options interface{}) (*Article, error) {
+	path := fmt.Sprintf("%s/%d/%s/%d.json", articlesBlogsPath, blogID, articlesBasePath, articleID)
+	resource := new(ArticleResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Article, err
+}
+
+// Create a new article in a blog
+func (s *ArticleServiceOp) Create(blogID int64, article Article) (*Article, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", articlesBlogsPath, blogID, articlesBasePath)
+	wrappedData := ArticleResource{Article: &article}
+	resource := new(ArticleResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Article, err
+}
+
+// Update an existing article of a blog
+func (s *ArticleServiceOp) Update(blogID int64, article Article) (*Article, error) {
+	path := fmt.Sprintf("%s/%d/%s/%d.json", articlesBlogsPath, blogID, articlesBasePath, article.ID)
+	wrappedData := ArticleResource{Article: &article}
+	resource := new(ArticleResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.Article, err
+}
+
+// Delete an existing article of a blog
+func (s *ArticleServiceOp) Delete(blogID int64, articleID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d/%s/%d.json", articlesBlogsPath, blogID, articlesBasePath, articleID))
+}
+
+// List metafields for an article
+func (s *ArticleServiceOp) ListMetafields(articleID int64, options interface{}) ([]Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.List(options)
+}
+
+// Count metafields for an article
+func (s *ArticleServiceOp) CountMetafields(articleID int64, options interface{}) (int, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Count(options)
+}
+
+// Get individual metafield for an article
+func (s *ArticleServiceOp) GetMetafield(articleID int64, metafieldID int64, options interface{}) (*Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Get(metafieldID, options)
+}
+
+// Create a new metafield for an article
+func (s *ArticleServiceOp) CreateMetafield(articleID int64, metafield Metafield) (*Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Create(metafield)
+}
+
+// Update an existing metafield for an article
+func (s *ArticleServiceOp) UpdateMetafield(articleID int64, metafield Metafield) (*Metafield, error) {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Update(metafield)
+}
+
+// Delete an existing metafield for an article
+func (s *ArticleServiceOp) DeleteMetafield(articleID int64, metafieldID int64) error {
+	metafieldService := &MetafieldServiceOp{client: s.client, resource: articlesResourceName, resourceID: articleID}
+	return metafieldService.Delete(metafieldID)
+}
+
 func (s *ArticleServiceOp) GetOrderList() []string {
 	str := new(Article)
 
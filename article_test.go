@@ -0,0 +1,202 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func articleTests(t *testing.T, article Article) {
+	if article.ID != 134645308 {
+		t.Errorf("Article.ID returned %+v, expected %+v", article.ID, 134645308)
+	}
+
+	if article.BlogID != 241253187 {
+		t.Errorf("Article.BlogID returned %+v, expected %+v", article.BlogID, 241253187)
+	}
+
+	expectedTitle := "My new Article title"
+	if article.Title != expectedTitle {
+		t.Errorf("Article.Title returned %+v, expected %+v", article.Title, expectedTitle)
+	}
+
+	if article.Image == nil || article.Image.Alt != "new alt tag content" {
+		t.Errorf("Article.Image returned %+v, expected alt new alt tag content", article.Image)
+	}
+}
+
+func TestArticleGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/blogs/241253187/articles/134645308.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("article.json")))
+
+	article, err := client.Article.Get(241253187, 134645308, nil)
+	if err != nil {
+		t.Fatalf("Article.Get returned error: %v", err)
+	}
+
+	articleTests(t, *article)
+}
+
+func TestArticleCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/blogs/241253187/articles/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 4}`))
+
+	params := map[string]string{"tag": "This Post", "published_status": "published"}
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/blogs/241253187/articles/count.json", client.pathPrefix),
+		params, httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.Article.Count(241253187, nil)
+	if err != nil {
+		t.Errorf("Article.Count returned error: %v", err)
+	}
+
+	expected := 4
+	if cnt != expected {
+		t.Errorf("Article.Count returned %d, expected %d", cnt, expected)
+	}
+
+	cnt, err = client.Article.Count(241253187, ArticleListOptions{Tag: "This Post", PublishedStatus: "published"})
+	if err != nil {
+		t.Errorf("Article.Count returned error: %v", err)
+	}
+
+	expected = 2
+	if cnt != expected {
+		t.Errorf("Article.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestArticleCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body map[string]map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/blogs/241253187/articles.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			return httpmock.NewBytesResponse(201, loadFixture("article.json")), nil
+		})
+
+	published := false
+	article := Article{
+		Title:     "My new Article title",
+		Author:    "John Smith",
+		Tags:      "This Post, Has Been Tagged",
+		BodyHTML:  "<p>I like articles</p>",
+		Published: &published,
+	}
+	article.SetImageAttachment([]byte("GIF89a"), "new alt tag content")
+
+	returnedArticle, err := client.Article.Create(241253187, article)
+	if err != nil {
+		t.Fatalf("Article.Create returned error: %v", err)
+	}
+
+	articleTests(t, *returnedArticle)
+
+	sent := body["article"]
+	if sent["published"] != false {
+		t.Errorf("Article.Create sent published %v, expected false", sent["published"])
+	}
+	if _, ok := sent["published_at"]; ok {
+		t.Errorf("Article.Create sent published_at %v, expected it to be left out", sent["published_at"])
+	}
+
+	expectedImage := map[string]interface{}{"attachment": "R0lGODlh", "alt": "new alt tag content"}
+	if !reflect.DeepEqual(sent["image"], expectedImage) {
+		t.Errorf("Article.Create sent image %v, expected %v", sent["image"], expectedImage)
+	}
+}
+
+func TestArticleUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/blogs/241253187/articles/134645308.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("article.json")))
+
+	article, err := client.Article.Update(241253187, Article{ID: 134645308, Title: "My new Article title"})
+	if err != nil {
+		t.Fatalf("Article.Update returned error: %v", err)
+	}
+
+	articleTests(t, *article)
+}
+
+func TestArticleDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/blogs/241253187/articles/134645308.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Article.Delete(241253187, 134645308)
+	if err != nil {
+		t.Errorf("Article.Delete returned error: %v", err)
+	}
+}
+
+func TestArticleListMetafields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/articles/1/metafields.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"metafields": [{"id":1},{"id":2}]}`))
+
+	metafields, err := client.Article.ListMetafields(1, nil)
+	if err != nil {
+		t.Errorf("Article.ListMetafields() returned error: %v", err)
+	}
+
+	expected := []Metafield{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(metafields, expected) {
+		t.Errorf("Article.ListMetafields() returned %+v, expected %+v", metafields, expected)
+	}
+}
+
+func TestArticleCreateMetafield(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/articles/1/metafields.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("metafield.json")))
+
+	metafield := Metafield{
+		Key:       "app_key",
+		Value:     "app_value",
+		Type:      "single_line_text_field",
+		Namespace: "affiliates",
+	}
+
+	returnedMetafield, err := client.Article.CreateMetafield(1, metafield)
+	if err != nil {
+		t.Errorf("Article.CreateMetafield() returned error: %v", err)
+	}
+
+	MetafieldTests(t, *returnedMetafield)
+}
+
+func TestArticleDeleteMetafield(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/articles/1/metafields/2.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Article.DeleteMetafield(1, 2)
+	if err != nil {
+		t.Errorf("Article.DeleteMetafield() returned error: %v", err)
+	}
+}
@@ -9,8 +9,15 @@ const (
 	carrierServicerBasePath = "carrier_services"
 )
 
+// CarrierServiceService is an interface for interfacing with the carrier
+// service endpoints of the Shopify API.
+// See: https://shopify.dev/api/admin-rest/latest/resources/carrierservice
 type CarrierServiceService interface {
 	List(interface{}) ([]CarrierService, error)
+	Get(int64, interface{}) (*CarrierService, error)
+	Create(CarrierService) (*CarrierService, error)
+	Update(CarrierService) (*CarrierService, error)
+	Delete(int64) error
 	GetOrderList() []string
 }
 
@@ -37,6 +44,12 @@ type CarrierServiceResource struct {
 	CarrierServices []CarrierService `json:"carrier_services"`
 }
 
+// SingleCarrierServiceResource represents the result from the
+// carrier_services/X.json endpoint
+type SingleCarrierServiceResource struct {
+	CarrierService *CarrierService `json:"carrier_service"`
+}
+
 // List carrierServicer
 func (s *CarrierServiceServiceOp) List(options interface{}) ([]CarrierService, error) {
 	path := fmt.Sprintf("%s.json", carrierServicerBasePath)
@@ -44,6 +57,37 @@ func (s *CarrierServiceServiceOp) List(options interface{}) ([]CarrierService, e
 	return resource.CarrierServices, s.client.Get(path, resource, options)
 }
 
+// Get individual carrier service
+func (s *CarrierServiceServiceOp) Get(carrierServiceID int64, options interface{}) (*CarrierService, error) {
+	path := fmt.Sprintf("%s/%d.json", carrierServicerBasePath, carrierServiceID)
+	resource := new(SingleCarrierServiceResource)
+	err := s.client.Get(path, resource, options)
+	return resource.CarrierService, err
+}
+
+// Create a new carrier service
+func (s *CarrierServiceServiceOp) Create(carrierService CarrierService) (*CarrierService, error) {
+	path := fmt.Sprintf("%s.json", carrierServicerBasePath)
+	wrappedData := SingleCarrierServiceResource{CarrierService: &carrierService}
+	resource := new(SingleCarrierServiceResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.CarrierService, err
+}
+
+// Update an existing carrier service
+func (s *CarrierServiceServiceOp) Update(carrierService CarrierService) (*CarrierService, error) {
+	path := fmt.Sprintf("%s/%d.json", carrierServicerBasePath, carrierService.ID)
+	wrappedData := SingleCarrierServiceResource{CarrierService: &carrierService}
+	resource := new(SingleCarrierServiceResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.CarrierService, err
+}
+
+// Delete an existing carrier service
+func (s *CarrierServiceServiceOp) Delete(carrierServiceID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d.json", carrierServicerBasePath, carrierServiceID))
+}
+
 func (s *CarrierServiceServiceOp) GetOrderList() []string {
 	str := new(CarrierService)
 
@@ -0,0 +1,192 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultCarrierRateTimeout = 10 * time.Second
+
+	// carrierRateDateLayout is the format of delivery dates in rate responses
+	carrierRateDateLayout = "2006-01-02 15:04:05 -0700"
+)
+
+// CarrierRateAddress is the origin or destination of a shipping rate request.
+type CarrierRateAddress struct {
+	Country     string `json:"country"`
+	PostalCode  string `json:"postal_code"`
+	Province    string `json:"province"`
+	City        string `json:"city"`
+	Name        string `json:"name"`
+	Address1    string `json:"address1"`
+	Address2    string `json:"address2"`
+	Address3    string `json:"address3"`
+	Phone       string `json:"phone"`
+	Fax         string `json:"fax"`
+	Email       string `json:"email"`
+	AddressType string `json:"address_type"`
+	CompanyName string `json:"company_name"`
+}
+
+// CarrierRateItem is an item to ship in a shipping rate request. Price is in
+// cents of the request currency.
+type CarrierRateItem struct {
+	Name               string                 `json:"name"`
+	SKU                string                 `json:"sku"`
+	Quantity           int                    `json:"quantity"`
+	Grams              int                    `json:"grams"`
+	Price              int64                  `json:"price"`
+	Vendor             string                 `json:"vendor"`
+	RequiresShipping   bool                   `json:"requires_shipping"`
+	Taxable            bool                   `json:"taxable"`
+	FulfillmentService string                 `json:"fulfillment_service"`
+	Properties         map[string]interface{} `json:"properties"`
+	ProductID          int64                  `json:"product_id"`
+	VariantID          int64                  `json:"variant_id"`
+}
+
+// CarrierRateRequest is the shipping rate request Shopify sends to the
+// callback_url of a carrier service.
+type CarrierRateRequest struct {
+	Origin      CarrierRateAddress `json:"origin"`
+	Destination CarrierRateAddress `json:"destination"`
+	Items       []CarrierRateItem  `json:"items"`
+	Currency    string             `json:"currency"`
+	Locale      string             `json:"locale"`
+}
+
+// CarrierRate is a shipping rate returned to Shopify. TotalPrice is in cents
+// of Currency.
+type CarrierRate struct {
+	ServiceName     string
+	ServiceCode     string
+	TotalPrice      int64
+	Description     string
+	Currency        string
+	MinDeliveryDate *time.Time
+	MaxDeliveryDate *time.Time
+	PhoneRequired   bool
+}
+
+// MarshalJSON encodes the rate the way Shopify expects it, with the total
+// price as a string of cents and delivery dates as "2006-01-02 15:04:05 -0700".
+func (r CarrierRate) MarshalJSON() ([]byte, error) {
+	rate := struct {
+		ServiceName     string `json:"service_name"`
+		ServiceCode     string `json:"service_code"`
+		TotalPrice      string `json:"total_price"`
+		Description     string `json:"description,omitempty"`
+		Currency        string `json:"currency"`
+		MinDeliveryDate string `json:"min_delivery_date,omitempty"`
+		MaxDeliveryDate string `json:"max_delivery_date,omitempty"`
+		PhoneRequired   bool   `json:"phone_required,omitempty"`
+	}{
+		ServiceName:   r.ServiceName,
+		ServiceCode:   r.ServiceCode,
+		TotalPrice:    strconv.FormatInt(r.TotalPrice, 10),
+		Description:   r.Description,
+		Currency:      r.Currency,
+		PhoneRequired: r.PhoneRequired,
+	}
+	if r.MinDeliveryDate != nil {
+		rate.MinDeliveryDate = r.MinDeliveryDate.Format(carrierRateDateLayout)
+	}
+	if r.MaxDeliveryDate != nil {
+		rate.MaxDeliveryDate = r.MaxDeliveryDate.Format(carrierRateDateLayout)
+	}
+	return json.Marshal(rate)
+}
+
+// RateProvider computes shipping rates for a carrier service. The context is
+// cancelled once the handler's timeout expires.
+type RateProvider interface {
+	Rates(ctx context.Context, shop string, request CarrierRateRequest) ([]CarrierRate, error)
+}
+
+// CarrierRateHandler is an http.Handler for the shipping rate requests Shopify
+// sends to the callback_url of a carrier service. Requests are verified with
+// the App's ApiSecret and answered with the rates of the RateProvider.
+//
+// When the provider fails or does not answer within Timeout the handler
+// responds with a 503 so that Shopify falls back to its backup rates.
+type CarrierRateHandler struct {
+	app      App
+	provider RateProvider
+
+	// Timeout is the time the provider has to compute rates, defaults to
+	// 10 seconds.
+	Timeout time.Duration
+}
+
+// NewCarrierRateHandler returns a CarrierRateHandler verifying requests with
+// the App's ApiSecret.
+func (app App) NewCarrierRateHandler(provider RateProvider) *CarrierRateHandler {
+	return &CarrierRateHandler{
+		app:      app,
+		provider: provider,
+		Timeout:  defaultCarrierRateTimeout,
+	}
+}
+
+// ServeHTTP verifies a shipping rate request and responds with the rates.
+func (h *CarrierRateHandler) ServeHTTP(w http.ResponseWriter, httpRequest *http.Request) {
+	if !allowMethod(w, httpRequest, http.MethodPost) {
+		return
+	}
+
+	if ok, err := h.app.VerifyWebhookRequestVerbose(httpRequest); !ok || err != nil {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	body, err := ioutil.ReadAll(httpRequest.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resource := struct {
+		Rate CarrierRateRequest `json:"rate"`
+	}{}
+	if err := json.Unmarshal(body, &resource); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	timeout := h.Timeout
+	if timeout <= 0 {
+		timeout = defaultCarrierRateTimeout
+	}
+	ctx, cancel := context.WithTimeout(httpRequest.Context(), timeout)
+	defer cancel()
+
+	type result struct {
+		rates []CarrierRate
+		err   error
+	}
+	done := make(chan result, 1)
+	shop := httpRequest.Header.Get(webhookShopDomainHeader)
+	go func() {
+		rates, err := h.provider.Rates(ctx, shop, resource.Rate)
+		done <- result{rates, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+	case res := <-done:
+		if res.err != nil {
+			http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+			return
+		}
+		if res.rates == nil {
+			res.rates = []CarrierRate{}
+		}
+		writeJSON(w, map[string][]CarrierRate{"rates": res.rates})
+	}
+}
@@ -0,0 +1,162 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestCarrierServiceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/carrier_services/1036894960.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"carrier_service":{"id":1036894960,"name":"Shipping Rate Provider","active":true,"callback_url":"http://shipping.example.com/"}}`))
+
+	carrierService, err := client.CarrierService.Get(1036894960, nil)
+	if err != nil {
+		t.Fatalf("CarrierService.Get returned error: %v", err)
+	}
+
+	expected := CarrierService{ID: 1036894960, Name: "Shipping Rate Provider", Active: true, CallbackURL: "http://shipping.example.com/"}
+	if *carrierService != expected {
+		t.Errorf("CarrierService.Get returned %+v, expected %+v", carrierService, expected)
+	}
+}
+
+func TestCarrierServiceCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/carrier_services.json", client.pathPrefix),
+		httpmock.NewStringResponder(201, `{"carrier_service":{"id":1036894960,"name":"Shipping Rate Provider"}}`))
+
+	carrierService, err := client.CarrierService.Create(CarrierService{Name: "Shipping Rate Provider", CallbackURL: "http://shipping.example.com/"})
+	if err != nil {
+		t.Fatalf("CarrierService.Create returned error: %v", err)
+	}
+
+	if carrierService.ID != 1036894960 {
+		t.Errorf("CarrierService.ID returned %d, expected 1036894960", carrierService.ID)
+	}
+}
+
+func TestCarrierServiceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/carrier_services/1036894960.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"carrier_service":{"id":1036894960,"active":false}}`))
+
+	carrierService, err := client.CarrierService.Update(CarrierService{ID: 1036894960})
+	if err != nil {
+		t.Fatalf("CarrierService.Update returned error: %v", err)
+	}
+
+	if carrierService.ID != 1036894960 {
+		t.Errorf("CarrierService.ID returned %d, expected 1036894960", carrierService.ID)
+	}
+}
+
+func TestCarrierServiceDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/carrier_services/1036894960.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.CarrierService.Delete(1036894960)
+	if err != nil {
+		t.Errorf("CarrierService.Delete returned error: %v", err)
+	}
+}
+
+type rateProviderFunc func(context.Context, string, CarrierRateRequest) ([]CarrierRate, error)
+
+func (f rateProviderFunc) Rates(ctx context.Context, shop string, request CarrierRateRequest) ([]CarrierRate, error) {
+	return f(ctx, shop, request)
+}
+
+const carrierRateRequestBody = `{"rate":{"origin":{"country":"CA","postal_code":"K2P1L4","province":"ON","city":"Ottawa"},` +
+	`"destination":{"country":"CA","postal_code":"K1M1M4","province":"ON","city":"Ottawa"},` +
+	`"items":[{"name":"Short Sleeve T-Shirt","sku":"","quantity":1,"grams":1000,"price":1999,"requires_shipping":true,"product_id":48447225880,"variant_id":258644705304}],` +
+	`"currency":"USD","locale":"en"}}`
+
+func TestCarrierRateHandler(t *testing.T) {
+	setup()
+	defer teardown()
+
+	minDate := time.Date(2013, time.April, 12, 14, 48, 45, 0, time.FixedZone("", -4*3600))
+	maxDate := minDate.Add(48 * time.Hour)
+
+	var got CarrierRateRequest
+	handler := app.NewCarrierRateHandler(rateProviderFunc(func(ctx context.Context, shop string, request CarrierRateRequest) ([]CarrierRate, error) {
+		got = request
+		return []CarrierRate{{
+			ServiceName:     "canadapost-overnight",
+			ServiceCode:     "ON",
+			TotalPrice:      1295,
+			Currency:        "CAD",
+			MinDeliveryDate: &minDate,
+			MaxDeliveryDate: &maxDate,
+		}}, nil
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, signedCallbackRequest(app.ApiSecret, "POST", "/rates", carrierRateRequestBody))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("CarrierRateHandler returned status %d, expected %d", rec.Code, http.StatusOK)
+	}
+
+	if got.Destination.PostalCode != "K1M1M4" || len(got.Items) != 1 || got.Items[0].Price != 1999 {
+		t.Errorf("CarrierRateHandler decoded %+v", got)
+	}
+
+	expected := `{"rates":[{"service_name":"canadapost-overnight","service_code":"ON","total_price":"1295","currency":"CAD",` +
+		`"min_delivery_date":"2013-04-12 14:48:45 -0400","max_delivery_date":"2013-04-14 14:48:45 -0400"}]}`
+	if rec.Body.String() != expected {
+		t.Errorf("CarrierRateHandler returned %s, expected %s", rec.Body.String(), expected)
+	}
+}
+
+func TestCarrierRateHandlerErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	slow := app.NewCarrierRateHandler(rateProviderFunc(func(ctx context.Context, shop string, request CarrierRateRequest) ([]CarrierRate, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}))
+	slow.Timeout = 10 * time.Millisecond
+
+	failing := app.NewCarrierRateHandler(rateProviderFunc(func(context.Context, string, CarrierRateRequest) ([]CarrierRate, error) {
+		return nil, errors.New("boom")
+	}))
+
+	cases := []struct {
+		name     string
+		handler  http.Handler
+		req      *http.Request
+		expected int
+	}{
+		{"timeout", slow, signedCallbackRequest(app.ApiSecret, "POST", "/rates", carrierRateRequestBody), http.StatusServiceUnavailable},
+		{"provider error", failing, signedCallbackRequest(app.ApiSecret, "POST", "/rates", carrierRateRequestBody), http.StatusServiceUnavailable},
+		{"bad signature", failing, signedCallbackRequest("wrong", "POST", "/rates", carrierRateRequestBody), http.StatusUnauthorized},
+		{"bad body", failing, signedCallbackRequest(app.ApiSecret, "POST", "/rates", `{"rate":[]}`), http.StatusBadRequest},
+	}
+
+	for _, c := range cases {
+		rec := httptest.NewRecorder()
+		c.handler.ServeHTTP(rec, c.req)
+		if rec.Code != c.expected {
+			t.Errorf("CarrierRateHandler %s returned status %d, expected %d", c.name, rec.Code, c.expected)
+		}
+	}
+}
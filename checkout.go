@@ -1,20 +1,48 @@
 package goshopify
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/shopspring/decimal"
 )
 
 const (
+	checkoutsPath                 = "checkouts"
 	checkoutsBasePath             = "checkouts/%s"
 	checkoutsShoppingRateBasePath = "checkouts/%s/shipping_rates"
 )
 
+// checkoutLocationPrefixRegex matches the API path prefix of a location
+var checkoutLocationPrefixRegex = regexp.MustCompile(`^admin/(api/[^/]+/)?`)
+
+var (
+	// checkoutPollInterval is the time waited between two polls of a
+	// checkout when Shopify does not send a Retry-After header.
+	checkoutPollInterval = time.Second
+
+	// checkoutPollTimeout is the time a checkout has to finish processing.
+	checkoutPollTimeout = time.Minute
+)
+
+// CheckoutService is an interface for interfacing with the checkout endpoints
+// of the Shopify API, available to sales channel apps.
+// See: https://shopify.dev/docs/admin-api/rest/reference/sales-channels/checkout
 type CheckoutService interface {
 	Get(string, interface{}) (*Checkout, error)
+	Create(Checkout) (*Checkout, error)
+	Update(Checkout) (*Checkout, error)
+	Complete(string) (*Checkout, error)
+	ShippingRateList(string, interface{}) ([]ShippingRate, error)
+	SelectShippingRate(string, string) (*Checkout, error)
+	// Deprecated: use ShippingRateList instead.
 	ShoppingRateList(string, interface{}) ([]ShoppingRate, error)
 	GetOrderList() []string
 }
@@ -68,8 +96,52 @@ type Checkout struct {
 	BillingAddress           *Address         `json:"billing_address"`
 	ShippingAddress          *Address         `json:"shipping_address"`
 	Customer                 *Customer        `json:"customer"`
+
+	// Fields of the sales channel checkout API
+	ShippingLine *CheckoutShippingLine `json:"shipping_line,omitempty"`
+	PaymentDue   *decimal.Decimal      `json:"payment_due,omitempty"`
+	PaymentURL   string                `json:"payment_url,omitempty"`
+	WebURL       string                `json:"web_url,omitempty"`
+}
+
+// CheckoutShippingLine is the shipping rate selected for a checkout. Only the
+// handle is needed to select a rate.
+type CheckoutShippingLine struct {
+	Handle string           `json:"handle"`
+	Price  *decimal.Decimal `json:"price,omitempty"`
+	Title  string           `json:"title,omitempty"`
+}
+
+// ShippingRate is a shipping rate available for a checkout. Its ID and Handle
+// are the same string.
+type ShippingRate struct {
+	ID                     string                `json:"id"`
+	Price                  *decimal.Decimal      `json:"price"`
+	Title                  string                `json:"title"`
+	Checkout               *ShippingRateCheckout `json:"checkout"`
+	PhoneRequired          bool                  `json:"phone_required"`
+	DeliveryRange          interface{}           `json:"delivery_range"`
+	EstimatedTimeInTransit interface{}           `json:"estimated_time_in_transit"`
+	Handle                 string                `json:"handle"`
 }
 
+// ShippingRateCheckout holds the totals of a checkout when the shipping rate
+// is selected.
+type ShippingRateCheckout struct {
+	TotalTax      *decimal.Decimal `json:"total_tax"`
+	TotalPrice    *decimal.Decimal `json:"total_price"`
+	SubtotalPrice *decimal.Decimal `json:"subtotal_price"`
+}
+
+// CheckoutShippingRatesResource represents the result from the
+// checkouts/X/shipping_rates.json endpoint
+type CheckoutShippingRatesResource struct {
+	ShippingRates []ShippingRate `json:"shipping_rates"`
+}
+
+// ShoppingRate is a misspelled shipping rate.
+//
+// Deprecated: use ShippingRate, whose ID is the string Shopify sends.
 type ShoppingRate struct {
 	ID                     int64                 `json:"id"`
 	Price                  string                `json:"price"`
@@ -81,12 +153,19 @@ type ShoppingRate struct {
 	Handle                 string                `json:"handle"`
 }
 
+// ShoppingRateCheckout is the misspelled ShippingRateCheckout.
+//
+// Deprecated: use ShippingRateCheckout.
 type ShoppingRateCheckout struct {
 	TotalTax      *decimal.Decimal `json:"total_tax"`
 	TotalPrice    *decimal.Decimal `json:"total_price"`
 	SubtotalPrice *decimal.Decimal `json:"subtotal_price"`
 }
 
+// CheckoutsShoppingRateResource represents the result from the
+// checkouts/X/shipping_rates.json endpoint with ShoppingRate.
+//
+// Deprecated: use CheckoutShippingRatesResource.
 type CheckoutsShoppingRateResource struct {
 	ShoppingRates []ShoppingRate `json:"shipping_rates"`
 }
@@ -95,16 +174,172 @@ type CheckoutResource struct {
 	Checkout *Checkout `json:"checkout"`
 }
 
+// checkoutCompletionResource is the result of completing a checkout: the
+// checkout itself, or the payment of the checkout when Shopify redirects the
+// polling to it.
+type checkoutCompletionResource struct {
+	Checkout *Checkout `json:"checkout"`
+	Payment  *struct {
+		Checkout *Checkout `json:"checkout"`
+	} `json:"payment"`
+}
+
+// Get a checkout by its token
 func (s *CheckoutServiceOp) Get(token string, options interface{}) (*Checkout, error) {
 	path := fmt.Sprintf(checkoutsBasePath+".json", token)
 	resource := &CheckoutResource{}
-	return resource.Checkout, s.client.Get(path, resource, options)
+	err := s.client.Get(path, resource, options)
+	return resource.Checkout, err
+}
+
+// Create a new checkout
+func (s *CheckoutServiceOp) Create(checkout Checkout) (*Checkout, error) {
+	path := fmt.Sprintf("%s.json", checkoutsPath)
+	wrappedData := CheckoutResource{Checkout: &checkout}
+	resource := new(CheckoutResource)
+	err := s.poll("POST", path, "", wrappedData, nil, resource)
+	return resource.Checkout, err
+}
+
+// Update an existing checkout, identified by its token
+func (s *CheckoutServiceOp) Update(checkout Checkout) (*Checkout, error) {
+	path := fmt.Sprintf(checkoutsBasePath+".json", checkout.Token)
+	wrappedData := CheckoutResource{Checkout: &checkout}
+	resource := new(CheckoutResource)
+	err := s.poll("PUT", path, path, wrappedData, nil, resource)
+	return resource.Checkout, err
+}
+
+// checkoutShippingLineResource is the payload selecting the shipping line of
+// a checkout, which leaves its other fields untouched.
+type checkoutShippingLineResource struct {
+	Checkout struct {
+		Token        string                `json:"token"`
+		ShippingLine *CheckoutShippingLine `json:"shipping_line"`
+	} `json:"checkout"`
+}
+
+// SelectShippingRate sets the shipping line of a checkout to the shipping
+// rate with the given handle, see ShippingRateList
+func (s *CheckoutServiceOp) SelectShippingRate(token string, handle string) (*Checkout, error) {
+	path := fmt.Sprintf(checkoutsBasePath+".json", token)
+	wrappedData := checkoutShippingLineResource{}
+	wrappedData.Checkout.Token = token
+	wrappedData.Checkout.ShippingLine = &CheckoutShippingLine{Handle: handle}
+	resource := new(CheckoutResource)
+	err := s.poll("PUT", path, path, wrappedData, nil, resource)
+	return resource.Checkout, err
+}
+
+// Complete a checkout that does not require a payment, and waits until it
+// is processed
+func (s *CheckoutServiceOp) Complete(token string) (*Checkout, error) {
+	path := fmt.Sprintf(checkoutsBasePath+"/complete.json", token)
+	resource := new(checkoutCompletionResource)
+	err := s.poll("POST", path, fmt.Sprintf(checkoutsBasePath+".json", token), nil, nil, resource)
+	if resource.Checkout == nil && resource.Payment != nil {
+		return resource.Payment.Checkout, err
+	}
+	return resource.Checkout, err
 }
 
+// ShippingRateList lists the shipping rates available for a checkout. Shopify
+// computes rates asynchronously, so the rates are polled until they are ready.
+func (s *CheckoutServiceOp) ShippingRateList(token string, options interface{}) ([]ShippingRate, error) {
+	path := fmt.Sprintf(checkoutsShoppingRateBasePath+".json", token)
+	resource := new(CheckoutShippingRatesResource)
+	err := s.poll("GET", path, path, nil, options, resource)
+	return resource.ShippingRates, err
+}
+
+// ShoppingRateList lists the shipping rates of a checkout without waiting for
+// them to be ready.
+//
+// Deprecated: use ShippingRateList instead.
 func (s *CheckoutServiceOp) ShoppingRateList(token string, options interface{}) ([]ShoppingRate, error) {
 	path := fmt.Sprintf(checkoutsShoppingRateBasePath+".json", token)
 	resource := &CheckoutsShoppingRateResource{}
-	return resource.ShoppingRates, s.client.Get(path, resource, options)
+	err := s.client.Get(path, resource, options)
+	return resource.ShoppingRates, err
+}
+
+// poll sends a request and, as long as Shopify answers with a 202 Accepted,
+// polls the Location of the response with GET requests until the response is
+// ready. Without a Location, pollPath is polled, or the checkout of the
+// accepted response when pollPath is empty. The final response is decoded
+// into resource.
+func (s *CheckoutServiceOp) poll(method, relPath, pollPath string, data, options, resource interface{}) error {
+	deadline := time.Now().Add(checkoutPollTimeout)
+	for {
+		body := new(json.RawMessage)
+		resp, err := s.client.createAndDoGetResponse(method, relPath, data, options, body)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode != http.StatusAccepted {
+			return json.Unmarshal(*body, resource)
+		}
+
+		nextPath, err := s.pollLocation(resp.Header.Get("Location"))
+		if err != nil {
+			return err
+		}
+		if nextPath != "" {
+			// The location already holds the query to poll
+			options = nil
+		} else {
+			nextPath = pollPath
+		}
+		if nextPath == "" {
+			accepted := new(CheckoutResource)
+			if len(*body) > 0 {
+				if err := json.Unmarshal(*body, accepted); err != nil {
+					return err
+				}
+			}
+			if accepted.Checkout == nil || accepted.Checkout.Token == "" {
+				return fmt.Errorf("accepted checkout has no token to poll")
+			}
+			pollPath = fmt.Sprintf(checkoutsBasePath+".json", accepted.Checkout.Token)
+			nextPath = pollPath
+		}
+
+		wait := checkoutPollInterval
+		if retryAfter, err := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64); err == nil {
+			wait = time.Duration(retryAfter * float64(time.Second))
+		}
+		if time.Now().Add(wait).After(deadline) {
+			return fmt.Errorf("checkout is still processing after %s", checkoutPollTimeout)
+		}
+		time.Sleep(wait)
+
+		method, relPath, data = "GET", nextPath, nil
+	}
+}
+
+// pollLocation returns the path of a Location header relative to the API
+// path prefix of the client, or an empty path when there is no location.
+func (s *CheckoutServiceOp) pollLocation(location string) (string, error) {
+	if location == "" {
+		return "", nil
+	}
+
+	u, err := url.Parse(location)
+	if err != nil {
+		return "", err
+	}
+	if u.IsAbs() && u.Host != s.client.baseURL.Host {
+		return "", fmt.Errorf("checkout location %s is not on the shop %s", location, s.client.baseURL.Host)
+	}
+
+	// The location may name another API version than the client's, whose
+	// path prefix is added back when polling
+	relPath := checkoutLocationPrefixRegex.ReplaceAllString(strings.TrimPrefix(u.Path, "/"), "")
+	if u.RawQuery != "" {
+		relPath += "?" + u.RawQuery
+	}
+	return relPath, nil
 }
 
 func (s *CheckoutServiceOp) GetOrderList() []string {
@@ -0,0 +1,238 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+const checkoutToken = "b490a9220cd14d7344024f4874f640a6"
+
+func acceptedResponder(body string) httpmock.Responder {
+	return httpmock.ResponderFromResponse(&http.Response{
+		StatusCode: http.StatusAccepted,
+		Body:       httpmock.NewRespBodyFromString(body),
+		Header:     http.Header{"Retry-After": {"0"}},
+	})
+}
+
+func TestCheckoutCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	checkoutPollInterval = 0
+	defer func() { checkoutPollInterval = time.Second }()
+
+	var sent map[string]map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/checkouts.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(http.StatusAccepted, fmt.Sprintf(`{"checkout":{"token":"%s"}}`, checkoutToken)), nil
+		})
+
+	polls := 0
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/checkouts/%s.json", client.pathPrefix, checkoutToken),
+		func(req *http.Request) (*http.Response, error) {
+			polls++
+			if polls < 2 {
+				return httpmock.NewStringResponse(http.StatusAccepted, ""), nil
+			}
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"checkout":{"token":"%s","email":"me@example.com","total_price":"398.00","payment_due":"398.00"}}`, checkoutToken)), nil
+		})
+
+	checkout, err := client.Checkout.Create(Checkout{
+		Email:     "me@example.com",
+		LineItems: []*LineItem{{VariantID: 39072856, Quantity: 5}},
+	})
+	if err != nil {
+		t.Fatalf("Checkout.Create returned error: %v", err)
+	}
+
+	if checkout.Token != checkoutToken || !checkout.PaymentDue.Equal(decimal.NewFromInt(398)) {
+		t.Errorf("Checkout.Create returned %+v, expected checkout %s", checkout, checkoutToken)
+	}
+
+	if polls != 2 {
+		t.Errorf("Checkout.Create polled %d times, expected 2", polls)
+	}
+
+	if sent["checkout"]["email"] != "me@example.com" {
+		t.Errorf("Checkout.Create sent %+v", sent)
+	}
+}
+
+func TestCheckoutShippingRateList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	checkoutPollInterval = 0
+	defer func() { checkoutPollInterval = time.Second }()
+
+	polls := 0
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/checkouts/%s/shipping_rates.json", client.pathPrefix, checkoutToken),
+		func(req *http.Request) (*http.Response, error) {
+			polls++
+			if polls < 3 {
+				return httpmock.NewStringResponse(http.StatusAccepted, `{"shipping_rates":[]}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"shipping_rates":[{"id":"shopify-Free%20Shipping-0.00","price":"0.00","title":"Free Shipping","checkout":{"total_tax":"0.00","total_price":"398.00","subtotal_price":"398.00"},"phone_required":false,"delivery_range":null,"estimated_time_in_transit":null,"handle":"shopify-Free%20Shipping-0.00"}]}`), nil
+		})
+
+	rates, err := client.Checkout.ShippingRateList(checkoutToken, nil)
+	if err != nil {
+		t.Fatalf("Checkout.ShippingRateList returned error: %v", err)
+	}
+
+	if polls != 3 {
+		t.Errorf("Checkout.ShippingRateList polled %d times, expected 3", polls)
+	}
+
+	if len(rates) != 1 || rates[0].Handle != "shopify-Free%20Shipping-0.00" || rates[0].ID != rates[0].Handle {
+		t.Errorf("Checkout.ShippingRateList returned %+v, expected the free shipping rate", rates)
+	}
+}
+
+func TestCheckoutSelectShippingRate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent map[string]map[string]interface{}
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/checkouts/%s.json", client.pathPrefix, checkoutToken),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"checkout":{"token":"%s","shipping_line":{"handle":"shopify-Free%%20Shipping-0.00","price":"0.00","title":"Free Shipping"}}}`, checkoutToken)), nil
+		})
+
+	checkout, err := client.Checkout.SelectShippingRate(checkoutToken, "shopify-Free%20Shipping-0.00")
+	if err != nil {
+		t.Fatalf("Checkout.SelectShippingRate returned error: %v", err)
+	}
+
+	if checkout.ShippingLine == nil || checkout.ShippingLine.Handle != "shopify-Free%20Shipping-0.00" {
+		t.Errorf("Checkout.SelectShippingRate returned %+v, expected the free shipping line", checkout)
+	}
+
+	expected := map[string]interface{}{
+		"token":         checkoutToken,
+		"shipping_line": map[string]interface{}{"handle": "shopify-Free%20Shipping-0.00"},
+	}
+	if !reflect.DeepEqual(sent["checkout"], expected) {
+		t.Errorf("Checkout.SelectShippingRate sent %v, expected only %v", sent["checkout"], expected)
+	}
+}
+
+func TestCheckoutPollLocation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/checkouts/%s/complete.json", client.pathPrefix, checkoutToken),
+		httpmock.ResponderFromResponse(&http.Response{
+			StatusCode: http.StatusAccepted,
+			Body:       httpmock.NewRespBodyFromString(""),
+			Header: http.Header{
+				"Retry-After": {"0"},
+				"Location":    {fmt.Sprintf("https://fooshop.myshopify.com/%s/checkouts/%s/payments/25428999.json", client.pathPrefix, checkoutToken)},
+			},
+		}))
+
+	polls := 0
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/checkouts/%s/payments/25428999.json", client.pathPrefix, checkoutToken),
+		func(req *http.Request) (*http.Response, error) {
+			polls++
+			if polls < 2 {
+				// The poll goes through the retries of the client
+				return httpmock.NewStringResponse(http.StatusServiceUnavailable, ""), nil
+			}
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"payment":{"id":25428999,"unique_token":"client-side-idempotency-token","payment_processing_error_message":null,"transaction":{"amount":"398.00","kind":"sale","status":"success"},"checkout":{"token":"%s","completed_at":"2021-12-01T14:04:58-05:00"}}}`, checkoutToken)), nil
+		})
+
+	checkout, err := client.Checkout.Complete(checkoutToken)
+	if err != nil {
+		t.Fatalf("Checkout.Complete returned error: %v", err)
+	}
+
+	if polls != 2 {
+		t.Errorf("Checkout.Complete polled the location %d times, expected 2", polls)
+	}
+
+	if checkout.CompletedAt == nil {
+		t.Errorf("Checkout.Complete returned %+v, expected a completed checkout", checkout)
+	}
+}
+
+func TestCheckoutPollLocationOtherVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ratesURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/checkouts/%s/shipping_rates.json", client.pathPrefix, checkoutToken)
+	polls := 0
+	httpmock.RegisterResponder("GET", ratesURL,
+		func(req *http.Request) (*http.Response, error) {
+			polls++
+			if polls < 2 {
+				resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+				resp.Header.Set("Retry-After", "0")
+				resp.Header.Set("Location", fmt.Sprintf("https://fooshop.myshopify.com/admin/api/2019-10/checkouts/%s/shipping_rates.json", checkoutToken))
+				return resp, nil
+			}
+			return httpmock.NewStringResponse(200, `{"shipping_rates":[{"id":"shopify-Free%20Shipping-0.00","handle":"shopify-Free%20Shipping-0.00"}]}`), nil
+		})
+
+	rates, err := client.Checkout.ShippingRateList(checkoutToken, nil)
+	if err != nil {
+		t.Fatalf("Checkout.ShippingRateList returned error: %v", err)
+	}
+
+	if polls != 2 || len(rates) != 1 {
+		t.Errorf("Checkout.ShippingRateList returned %+v after %d polls, expected 1 rate after 2", rates, polls)
+	}
+}
+
+func TestCheckoutComplete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/checkouts/%s/complete.json", client.pathPrefix, checkoutToken),
+		acceptedResponder(fmt.Sprintf(`{"checkout":{"token":"%s"}}`, checkoutToken)))
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/checkouts/%s.json", client.pathPrefix, checkoutToken),
+		httpmock.NewStringResponder(200, fmt.Sprintf(`{"checkout":{"token":"%s","completed_at":"2021-12-01T14:04:58-05:00"}}`, checkoutToken)))
+
+	checkout, err := client.Checkout.Complete(checkoutToken)
+	if err != nil {
+		t.Fatalf("Checkout.Complete returned error: %v", err)
+	}
+
+	if checkout.CompletedAt == nil {
+		t.Errorf("Checkout.Complete returned %+v, expected a completed checkout", checkout)
+	}
+}
+
+func TestCheckoutPollTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	checkoutPollTimeout = time.Second
+	defer func() { checkoutPollTimeout = time.Minute }()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/checkouts/%s/shipping_rates.json", client.pathPrefix, checkoutToken),
+		httpmock.ResponderFromResponse(&http.Response{
+			StatusCode: http.StatusAccepted,
+			Body:       httpmock.NewRespBodyFromString(""),
+			Header:     http.Header{"Retry-After": {"2"}},
+		}))
+
+	_, err := client.Checkout.ShippingRateList(checkoutToken, nil)
+	if err == nil {
+		t.Errorf("Checkout.ShippingRateList expected a timeout error")
+	}
+}
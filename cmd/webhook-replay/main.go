@@ -0,0 +1,86 @@
+// Command webhook-replay posts fixture payloads to a local webhook endpoint,
+// signed and with headers set exactly like Shopify does.
+//
+// Usage:
+//
+//	webhook-replay -url http://localhost:8080/webhooks -secret hush \
+//		-topic orders/create fixtures/order.json
+//
+// Fixtures wrapped in a single root key, like the ones returned by the admin
+// API ({"order": {...}}), can be sent unwrapped with -unwrap.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	goshopify "github.com/advalistar/go-shopify"
+)
+
+func main() {
+	url := flag.String("url", "http://localhost:8080/webhooks", "webhook endpoint")
+	secret := flag.String("secret", os.Getenv("SHOPIFY_API_SECRET"), "app API secret used to sign payloads")
+	shop := flag.String("shop", "fooshop", "shop sent in X-Shopify-Shop-Domain")
+	topic := flag.String("topic", "", "topic sent in X-Shopify-Topic")
+	version := flag.String("version", "", "API version sent in X-Shopify-API-Version")
+	unwrap := flag.Bool("unwrap", false, "send the value of the fixture's single root key")
+	flag.Parse()
+
+	if *topic == "" || *secret == "" || flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: webhook-replay -secret SECRET -topic TOPIC [flags] FIXTURE...")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	app := goshopify.App{ApiSecret: *secret}
+	sender := app.NewWebhookSender(*shop)
+	if *version != "" {
+		sender.APIVersion = *version
+	}
+
+	failed := false
+	for _, filename := range flag.Args() {
+		status, err := replay(sender, *url, *topic, filename, *unwrap)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", filename, err)
+			failed = true
+			continue
+		}
+		fmt.Printf("%s: %s\n", filename, status)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func replay(sender *goshopify.WebhookSender, url, topic, filename string, unwrap bool) (string, error) {
+	body, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+
+	if unwrap {
+		root := map[string]json.RawMessage{}
+		if err := json.Unmarshal(body, &root); err != nil {
+			return "", err
+		}
+		if len(root) != 1 {
+			return "", fmt.Errorf("cannot unwrap a fixture with %d root keys", len(root))
+		}
+		for _, value := range root {
+			body = value
+		}
+	}
+
+	resp, err := sender.Send(url, topic, body)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	return resp.Status, nil
+}
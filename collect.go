@@ -16,6 +16,10 @@ type CollectService interface {
 	List(interface{}) ([]Collect, error)
 	ListWithPagination(interface{}) ([]Collect, *Pagination, error)
 	Count(interface{}) (int, error)
+	Get(int64, interface{}) (*Collect, error)
+	Create(Collect) (*Collect, error)
+	Delete(int64) error
+	Sync(int64, []int64) (*CollectSyncResult, error)
 	GetOrderList() []string
 }
 
@@ -27,13 +31,27 @@ type CollectServiceOp struct {
 
 // Collect represents a Shopify collect
 type Collect struct {
-	ID           int64      `json:"id"`
+	ID           int64      `json:"id,omitempty"`
 	CollectionID int64      `json:"collection_id"`
 	ProductID    int64      `json:"product_id"`
-	CreatedAt    *time.Time `json:"created_at"`
-	UpdatedAt    *time.Time `json:"updated_at"`
-	Position     int        `json:"position"`
-	SortValue    string     `json:"sort_value"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+	Position     int        `json:"position,omitempty"`
+	SortValue    string     `json:"sort_value,omitempty"`
+}
+
+// CollectListOptions represents the options available when listing or
+// counting collects
+type CollectListOptions struct {
+	ListOptions
+	CollectionID int64 `url:"collection_id,omitempty"`
+	ProductID    int64 `url:"product_id,omitempty"`
+}
+
+// CollectSyncResult holds the collects created and deleted by Sync
+type CollectSyncResult struct {
+	Created []Collect
+	Deleted []Collect
 }
 
 // Represents the result from the collects/X.json endpoint
@@ -81,6 +99,87 @@ func (s *CollectServiceOp) Count(options interface{}) (int, error) {
 	return s.client.Count(path, options)
 }
 
+// Get individual collect
+func (s *CollectServiceOp) Get(collectID int64, options interface{}) (*Collect, error) {
+	path := fmt.Sprintf("%s/%d.json", collectsBasePath, collectID)
+	resource := new(CollectResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Collect, err
+}
+
+// Create a collect, adding a product to a custom collection
+func (s *CollectServiceOp) Create(collect Collect) (*Collect, error) {
+	path := fmt.Sprintf("%s.json", collectsBasePath)
+	wrappedData := CollectResource{Collect: &collect}
+	resource := new(CollectResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Collect, err
+}
+
+// Delete a collect, removing a product from a custom collection
+func (s *CollectServiceOp) Delete(collectID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d.json", collectsBasePath, collectID))
+}
+
+// Sync makes the products of a custom collection match productIDs. Only the
+// missing products are added, in the order of productIDs, and only the extra
+// products are removed, so the manual sort position of the products that stay
+// in the collection is preserved.
+func (s *CollectServiceOp) Sync(collectionID int64, productIDs []int64) (*CollectSyncResult, error) {
+	var current []Collect
+	var options interface{} = CollectListOptions{
+		ListOptions:  ListOptions{Limit: 250},
+		CollectionID: collectionID,
+	}
+	for options != nil {
+		collects, pagination, err := s.ListWithPagination(options)
+		if err != nil {
+			return nil, err
+		}
+		current = append(current, collects...)
+
+		options = nil
+		if pagination != nil && pagination.NextPageOptions != nil {
+			options = pagination.NextPageOptions
+		}
+	}
+
+	result := new(CollectSyncResult)
+	desired := make(map[int64]bool, len(productIDs))
+	for _, productID := range productIDs {
+		desired[productID] = true
+	}
+
+	existing := make(map[int64]bool, len(current))
+	for _, collect := range current {
+		if desired[collect.ProductID] {
+			existing[collect.ProductID] = true
+			continue
+		}
+		if err := s.Delete(collect.ID); err != nil {
+			return result, err
+		}
+		result.Deleted = append(result.Deleted, collect)
+	}
+
+	for _, productID := range productIDs {
+		if existing[productID] {
+			continue
+		}
+		collect, err := s.Create(Collect{CollectionID: collectionID, ProductID: productID})
+		if err != nil {
+			return result, err
+		}
+		if collect == nil {
+			return result, fmt.Errorf("collect of product %d in collection %d was not returned", productID, collectionID)
+		}
+		existing[productID] = true
+		result.Created = append(result.Created, *collect)
+	}
+
+	return result, nil
+}
+
 func (s *CollectServiceOp) GetOrderList() []string {
 	str := new(Collect)
 
@@ -2,6 +2,8 @@ package goshopify
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
 	"reflect"
 	"testing"
 
@@ -15,9 +17,9 @@ func collectTests(t *testing.T, collect Collect) {
 		expected interface{}
 		actual   interface{}
 	}{
-		{"ID", 18091352323, collect.ID},
-		{"CollectionID", 241600835, collect.CollectionID},
-		{"ProductID", 6654094787, collect.ProductID},
+		{"ID", int64(18091352323), collect.ID},
+		{"CollectionID", int64(241600835), collect.CollectionID},
+		{"ProductID", int64(6654094787), collect.ProductID},
 		{"SortValue", "0000000001", collect.SortValue},
 	}
 
@@ -79,3 +81,119 @@ func TestCollectCount(t *testing.T) {
 		t.Errorf("Collect.Count returned %d, expected %d", cnt, expected)
 	}
 }
+
+func TestCollectGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/collects/18091352323.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"collect":{"id":18091352323,"collection_id":241600835,"product_id":6654094787,"position":1,"sort_value":"0000000001"}}`))
+
+	collect, err := client.Collect.Get(18091352323, nil)
+	if err != nil {
+		t.Fatalf("Collect.Get returned error: %v", err)
+	}
+
+	collectTests(t, *collect)
+}
+
+func TestCollectCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/collects.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			body = string(b)
+			return httpmock.NewStringResponse(201, `{"collect":{"id":18091352323,"collection_id":241600835,"product_id":6654094787,"position":1,"sort_value":"0000000001"}}`), nil
+		})
+
+	collect, err := client.Collect.Create(Collect{CollectionID: 241600835, ProductID: 6654094787})
+	if err != nil {
+		t.Fatalf("Collect.Create returned error: %v", err)
+	}
+
+	collectTests(t, *collect)
+
+	expectedBody := `{"collect":{"collection_id":241600835,"product_id":6654094787}}`
+	if body != expectedBody {
+		t.Errorf("Collect.Create sent %s, expected %s", body, expectedBody)
+	}
+}
+
+func TestCollectDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/collects/18091352323.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Collect.Delete(18091352323)
+	if err != nil {
+		t.Errorf("Collect.Delete returned error: %v", err)
+	}
+}
+
+func TestCollectSync(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/collects.json", client.pathPrefix)
+	httpmock.RegisterResponderWithQuery("GET", listURL,
+		map[string]string{"collection_id": "5", "limit": "250"},
+		httpmock.ResponderFromResponse(&http.Response{
+			StatusCode: 200,
+			Body:       httpmock.NewRespBodyFromString(`{"collects":[{"id":11,"collection_id":5,"product_id":1,"position":1},{"id":12,"collection_id":5,"product_id":2,"position":2}]}`),
+			Header: http.Header{
+				"Link": {`<http://valid.url?limit=250&page_info=pageInfoCode>; rel="next"`},
+			},
+		}))
+	httpmock.RegisterResponderWithQuery("GET", listURL,
+		map[string]string{"page_info": "pageInfoCode", "limit": "250"},
+		httpmock.NewStringResponder(200, `{"collects":[{"id":13,"collection_id":5,"product_id":3,"position":3}]}`))
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/collects/12.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+	httpmock.RegisterResponder("POST", listURL,
+		httpmock.NewStringResponder(201, `{"collect":{"id":14,"collection_id":5,"product_id":4,"position":4}}`))
+
+	result, err := client.Collect.Sync(5, []int64{3, 4, 1})
+	if err != nil {
+		t.Fatalf("Collect.Sync returned error: %v", err)
+	}
+
+	expected := &CollectSyncResult{
+		Created: []Collect{{ID: 14, CollectionID: 5, ProductID: 4, Position: 4}},
+		Deleted: []Collect{{ID: 12, CollectionID: 5, ProductID: 2, Position: 2}},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Collect.Sync returned %+v, expected %+v", result, expected)
+	}
+
+	info := httpmock.GetCallCountInfo()
+	if calls := info["POST "+listURL]; calls != 1 {
+		t.Errorf("Collect.Sync created %d collects, expected 1", calls)
+	}
+}
+
+func TestCollectSyncMissingCollect(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/collects.json", client.pathPrefix)
+	httpmock.RegisterResponder("GET", listURL, httpmock.NewStringResponder(200, `{}`))
+	httpmock.RegisterResponder("POST", listURL, httpmock.NewStringResponder(201, `{}`))
+
+	result, err := client.Collect.Sync(5, []int64{4})
+	if err == nil {
+		t.Errorf("Collect.Sync expected an error when the created collect is not returned")
+	}
+
+	if result == nil || len(result.Created) != 0 {
+		t.Errorf("Collect.Sync returned %+v, expected no created collects", result)
+	}
+}
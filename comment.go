@@ -11,11 +11,30 @@ const (
 	commentsBasePath = "comments"
 )
 
+// Statuses of a comment.
+const (
+	CommentStatusPending    = "pending"
+	CommentStatusUnapproved = "unapproved"
+	CommentStatusPublished  = "published"
+	CommentStatusSpam       = "spam"
+	CommentStatusRemoved    = "removed"
+)
+
+// CommentService is an interface for interacting with the comments
+// endpoints of the Shopify API.
+// See https://shopify.dev/docs/admin-api/rest/reference/online-store/comment
 type CommentService interface {
 	List(interface{}) ([]Comment, error)
 	ListWithPagination(interface{}) ([]Comment, *Pagination, error)
 	Count(interface{}) (int, error)
 	Get(int64, interface{}) (*Comment, error)
+	Create(Comment) (*Comment, error)
+	Update(Comment) (*Comment, error)
+	Spam(int64) (*Comment, error)
+	NotSpam(int64) (*Comment, error)
+	Approve(int64) (*Comment, error)
+	Remove(int64) (*Comment, error)
+	Restore(int64) (*Comment, error)
 	GetOrderList() []string
 }
 
@@ -52,6 +71,18 @@ type CommentsResource struct {
 	Comments []Comment `json:"comments"`
 }
 
+// CommentListOptions represents the options available when listing or
+// counting comments
+type CommentListOptions struct {
+	ListOptions
+	BlogID          int64     `url:"blog_id,omitempty"`
+	ArticleID       int64     `url:"article_id,omitempty"`
+	Status          string    `url:"status,omitempty"`
+	PublishedStatus string    `url:"published_status,omitempty"`
+	PublishedAtMin  time.Time `url:"published_at_min,omitempty"`
+	PublishedAtMax  time.Time `url:"published_at_max,omitempty"`
+}
+
 // List comments
 func (s *CommentServiceOp) List(options interface{}) ([]Comment, error) {
 	path := fmt.Sprintf("%s.json", commentsBasePath)
@@ -95,6 +126,62 @@ func (s *CommentServiceOp) Get(commentID int64, options interface{}) (*Comment,
 	return resource.Comment, err
 }
 
+// Create a new comment on an article
+func (s *CommentServiceOp) Create(comment Comment) (*Comment, error) {
+	path := fmt.Sprintf("%s.json", commentsBasePath)
+	wrappedData := CommentResource{Comment: &comment}
+	resource := new(CommentResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Comment, err
+}
+
+// Update an existing comment
+func (s *CommentServiceOp) Update(comment Comment) (*Comment, error) {
+	path := fmt.Sprintf("%s/%d.json", commentsBasePath, comment.ID)
+	wrappedData := CommentResource{Comment: &comment}
+	resource := new(CommentResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.Comment, err
+}
+
+// Spam marks a comment as spam
+func (s *CommentServiceOp) Spam(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "spam")
+}
+
+// NotSpam marks a comment as not spam, which makes it pending or published
+// depending on the settings of the blog
+func (s *CommentServiceOp) NotSpam(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "not_spam")
+}
+
+// Approve approves and publishes a comment
+func (s *CommentServiceOp) Approve(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "approve")
+}
+
+// Remove removes a comment
+func (s *CommentServiceOp) Remove(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "remove")
+}
+
+// Restore restores a removed comment
+func (s *CommentServiceOp) Restore(commentID int64) (*Comment, error) {
+	return s.moderate(commentID, "restore")
+}
+
+// moderate posts to a moderation endpoint of a comment, which responds with
+// the comment itself rather than wrapped in a comment resource
+func (s *CommentServiceOp) moderate(commentID int64, action string) (*Comment, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", commentsBasePath, commentID, action)
+	resource := new(Comment)
+	err := s.client.Post(path, nil, resource)
+	if err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
 func (s *CommentServiceOp) GetOrderList() []string {
 	str := new(Comment)
 
@@ -0,0 +1,103 @@
+package goshopify
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestCommentList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"blog_id": "241253187", "article_id": "134645308", "status": "unapproved"}
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments.json", client.pathPrefix),
+		params, httpmock.NewStringResponder(200, `{"comments":[{"id":653537639,"status":"unapproved"},{"id":118373535,"status":"unapproved"}]}`))
+
+	comments, err := client.Comment.List(CommentListOptions{
+		BlogID:    241253187,
+		ArticleID: 134645308,
+		Status:    CommentStatusUnapproved,
+	})
+	if err != nil {
+		t.Fatalf("Comment.List returned error: %v", err)
+	}
+
+	if len(comments) != 2 || comments[0].ID != 653537639 {
+		t.Errorf("Comment.List returned %+v, expected comments 653537639 and 118373535", comments)
+	}
+}
+
+func TestCommentCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments.json", client.pathPrefix),
+		httpmock.NewStringResponder(201, `{"comment":{"id":757536352,"body":"I like comments\nAnd I like posting them *RESTfully*.","author":"Your name","email":"your@email.com","status":"pending","article_id":134645308,"blog_id":241253187}}`))
+
+	comment, err := client.Comment.Create(Comment{
+		Body:      "I like comments\nAnd I like posting them *RESTfully*.",
+		Author:    "Your name",
+		Email:     "your@email.com",
+		Ip:        "107.20.160.121",
+		BlogID:    241253187,
+		ArticleID: 134645308,
+	})
+	if err != nil {
+		t.Fatalf("Comment.Create returned error: %v", err)
+	}
+
+	if comment.ID != 757536352 || comment.Status != CommentStatusPending {
+		t.Errorf("Comment.Create returned %+v, expected pending comment 757536352", comment)
+	}
+}
+
+func TestCommentUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments/118373535.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"comment":{"id":118373535,"body":"You can even update through a web service.","author":"Your new name"}}`))
+
+	comment, err := client.Comment.Update(Comment{ID: 118373535, Body: "You can even update through a web service.", Author: "Your new name"})
+	if err != nil {
+		t.Fatalf("Comment.Update returned error: %v", err)
+	}
+
+	if comment.ID != 118373535 || comment.Author != "Your new name" {
+		t.Errorf("Comment.Update returned %+v, expected comment 118373535", comment)
+	}
+}
+
+func TestCommentModeration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		action   string
+		moderate func(int64) (*Comment, error)
+		status   string
+	}{
+		{"spam", client.Comment.Spam, CommentStatusSpam},
+		{"not_spam", client.Comment.NotSpam, CommentStatusPublished},
+		{"approve", client.Comment.Approve, CommentStatusPublished},
+		{"remove", client.Comment.Remove, CommentStatusRemoved},
+		{"restore", client.Comment.Restore, CommentStatusPublished},
+	}
+
+	for _, c := range cases {
+		httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments/653537639/%s.json", client.pathPrefix, c.action),
+			httpmock.NewStringResponder(201, fmt.Sprintf(`{"id":653537639,"body":"Hi author, I really _like_ what you're doing there.","status":"%s"}`, c.status)))
+
+		comment, err := c.moderate(653537639)
+		if err != nil {
+			t.Errorf("Comment %s returned error: %v", c.action, err)
+			continue
+		}
+
+		if comment.ID != 653537639 || comment.Status != c.status {
+			t.Errorf("Comment %s returned %+v, expected status %s", c.action, comment, c.status)
+		}
+	}
+}
@@ -28,6 +28,8 @@ type CustomerService interface {
 	Delete(int64) error
 	ListOrders(int64, interface{}) ([]Order, error)
 	ListTags(interface{}) ([]string, error)
+	SendInvite(int64, CustomerInvite) (*CustomerInvite, error)
+	AccountActivationURL(int64) (string, error)
 	GetOrderList() []string
 
 	// MetafieldsService used for Customer resource to communicate with Metafields resource
@@ -99,6 +101,26 @@ type CustomerTagsResource struct {
 	Tags []string `json:"tags"`
 }
 
+// CustomerInvite represents the account invite sent to a customer. Empty
+// fields fall back to the shop's defaults.
+type CustomerInvite struct {
+	To            string   `json:"to,omitempty"`
+	From          string   `json:"from,omitempty"`
+	Subject       string   `json:"subject,omitempty"`
+	CustomMessage string   `json:"custom_message,omitempty"`
+	Bcc           []string `json:"bcc,omitempty"`
+}
+
+// Represents the result from the customers/X/send_invite.json endpoint
+type CustomerInviteResource struct {
+	CustomerInvite *CustomerInvite `json:"customer_invite"`
+}
+
+// Represents the result from the customers/X/account_activation_url.json endpoint
+type CustomerAccountActivationURLResource struct {
+	AccountActivationURL string `json:"account_activation_url"`
+}
+
 // Represents the options available when searching for a customer
 type CustomerSearchOptions struct {
 	Page   int    `url:"page,omitempty"`
@@ -199,6 +221,25 @@ func (s *CustomerServiceOp) ListTags(options interface{}) ([]string, error) {
 	return resource.Tags, err
 }
 
+// SendInvite sends an account invite to a customer
+func (s *CustomerServiceOp) SendInvite(customerID int64, invite CustomerInvite) (*CustomerInvite, error) {
+	path := fmt.Sprintf("%s/%d/send_invite.json", customersBasePath, customerID)
+	wrappedData := CustomerInviteResource{CustomerInvite: &invite}
+	resource := new(CustomerInviteResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.CustomerInvite, err
+}
+
+// AccountActivationURL generates a one-time URL to activate the account of a
+// customer that has not accepted an invite yet. The URL expires after 30 days
+// and generating a new one invalidates the previous one.
+func (s *CustomerServiceOp) AccountActivationURL(customerID int64) (string, error) {
+	path := fmt.Sprintf("%s/%d/account_activation_url.json", customersBasePath, customerID)
+	resource := new(CustomerAccountActivationURLResource)
+	err := s.client.Post(path, nil, resource)
+	return resource.AccountActivationURL, err
+}
+
 func (s *CustomerServiceOp) GetOrderList() []string {
 	str := new(Customer)
 
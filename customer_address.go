@@ -2,6 +2,7 @@ package goshopify
 
 import (
 	"fmt"
+	"net/http"
 	"reflect"
 )
 
@@ -12,10 +13,13 @@ const customerAddressResourceName = "customer-addresses"
 // See: https://help.shopify.com/en/api/reference/customers/customer_address
 type CustomerAddressService interface {
 	List(int64, interface{}) ([]CustomerAddress, error)
+	ListWithPagination(int64, interface{}) ([]CustomerAddress, *Pagination, error)
 	Get(int64, int64, interface{}) (*CustomerAddress, error)
 	Create(int64, CustomerAddress) (*CustomerAddress, error)
 	Update(int64, CustomerAddress) (*CustomerAddress, error)
 	Delete(int64, int64) error
+	SetDefault(int64, int64) (*CustomerAddress, error)
+	DeleteMultiple(int64, []int64) error
 	GetOrderList() []string
 }
 
@@ -56,6 +60,13 @@ type CustomerAddressesResource struct {
 	Addresses []CustomerAddress `json:"addresses"`
 }
 
+// customerAddressSetOptions are the query parameters of the
+// customers/X/addresses/set.json endpoint
+type customerAddressSetOptions struct {
+	AddressIDs []int64 `url:"address_ids[]"`
+	Operation  string  `url:"operation"`
+}
+
 // List addresses
 func (s *CustomerAddressServiceOp) List(customerID int64, options interface{}) ([]CustomerAddress, error) {
 	path := fmt.Sprintf("%s/%d/addresses.json", customersBasePath, customerID)
@@ -64,6 +75,28 @@ func (s *CustomerAddressServiceOp) List(customerID int64, options interface{}) (
 	return resource.Addresses, err
 }
 
+// ListWithPagination lists addresses and returns pagination to retrieve next/previous results.
+func (s *CustomerAddressServiceOp) ListWithPagination(customerID int64, options interface{}) ([]CustomerAddress, *Pagination, error) {
+	path := fmt.Sprintf("%s/%d/addresses.json", customersBasePath, customerID)
+	resource := new(CustomerAddressesResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Addresses, pagination, nil
+}
+
 // Get address
 func (s *CustomerAddressServiceOp) Get(customerID, addressID int64, options interface{}) (*CustomerAddress, error) {
 	path := fmt.Sprintf("%s/%d/addresses/%d.json", customersBasePath, customerID, addressID)
@@ -95,6 +128,25 @@ func (s *CustomerAddressServiceOp) Delete(customerID, addressID int64) error {
 	return s.client.Delete(fmt.Sprintf("%s/%d/addresses/%d.json", customersBasePath, customerID, addressID))
 }
 
+// SetDefault sets the default address of a customer
+func (s *CustomerAddressServiceOp) SetDefault(customerID, addressID int64) (*CustomerAddress, error) {
+	path := fmt.Sprintf("%s/%d/addresses/%d/default.json", customersBasePath, customerID, addressID)
+	resource := new(CustomerAddressResource)
+	err := s.client.Put(path, nil, resource)
+	return resource.Address, err
+}
+
+// DeleteMultiple deletes several addresses of a customer at once. The default
+// address of a customer cannot be deleted.
+func (s *CustomerAddressServiceOp) DeleteMultiple(customerID int64, addressIDs []int64) error {
+	if len(addressIDs) == 0 {
+		return nil
+	}
+	path := fmt.Sprintf("%s/%d/addresses/set.json", customersBasePath, customerID)
+	options := customerAddressSetOptions{AddressIDs: addressIDs, Operation: "destroy"}
+	return s.client.CreateAndDo("PUT", path, nil, options, nil)
+}
+
 func (s *CustomerAddressServiceOp) GetOrderList() []string {
 	str := new(CustomerAddress)
 
@@ -2,6 +2,9 @@ package goshopify
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
@@ -164,3 +167,60 @@ func TestDelete(t *testing.T) {
 		t.Errorf("CustomerAddress.Update returned error: %v", err)
 	}
 }
+
+func TestListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers/1/addresses.json", client.pathPrefix),
+		httpmock.ResponderFromResponse(&http.Response{
+			StatusCode: 200,
+			Body:       httpmock.NewRespBodyFromBytes(loadFixture("customer_addresses.json")),
+			Header: http.Header{
+				"Link": {`<http://valid.url?limit=2&page_info=pageInfoCode>; rel="next"`},
+			},
+		}))
+
+	addresses, page, err := client.CustomerAddress.ListWithPagination(1, ListOptions{Limit: 2})
+	if err != nil {
+		t.Errorf("CustomerAddress.ListWithPagination returned error: %v", err)
+	}
+
+	if len(addresses) != 2 {
+		t.Errorf("CustomerAddress.ListWithPagination got %v addresses, expected 2", len(addresses))
+	}
+	verifyAddress(t, addresses[0])
+
+	expectedPage := &ListOptions{PageInfo: "pageInfoCode", Limit: 2}
+	if page == nil || !reflect.DeepEqual(page.NextPageOptions, expectedPage) {
+		t.Errorf("CustomerAddress.ListWithPagination returned pagination %+v, expected next page %+v", page, expectedPage)
+	}
+}
+
+func TestSetDefault(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers/1/addresses/1/default.json", client.pathPrefix), httpmock.NewBytesResponder(200, loadFixture("customer_address.json")))
+
+	address, err := client.CustomerAddress.SetDefault(1, 1)
+	if err != nil {
+		t.Errorf("CustomerAddress.SetDefault returned error: %v", err)
+	}
+
+	verifyAddress(t, *address)
+}
+
+func TestDeleteMultiple(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := url.Values{"address_ids[]": {"1", "2"}, "operation": {"destroy"}}
+	httpmock.RegisterResponderWithQuery("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers/1/addresses/set.json", client.pathPrefix),
+		params, httpmock.NewStringResponder(200, "{}"))
+
+	err := client.CustomerAddress.DeleteMultiple(1, []int64{1, 2})
+	if err != nil {
+		t.Errorf("CustomerAddress.DeleteMultiple returned error: %v", err)
+	}
+}
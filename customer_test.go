@@ -489,3 +489,45 @@ func TestCustomerListTags(t *testing.T) {
 		t.Errorf("Customer.ListTags got %v as the first tag, expected: 'tag1'", tags[0])
 	}
 }
+
+func TestCustomerSendInvite(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers/1/send_invite.json", client.pathPrefix),
+		httpmock.NewStringResponder(201, `{"customer_invite":{"to":"new_test_email@shopify.com","from":"j.limited@example.com","subject":"Welcome to my new shop","custom_message":"My awesome new store","bcc":["j.limited@example.com"]}}`))
+
+	invite := CustomerInvite{
+		To:            "new_test_email@shopify.com",
+		From:          "j.limited@example.com",
+		Subject:       "Welcome to my new shop",
+		CustomMessage: "My awesome new store",
+		Bcc:           []string{"j.limited@example.com"},
+	}
+	returnedInvite, err := client.Customer.SendInvite(1, invite)
+	if err != nil {
+		t.Errorf("Customer.SendInvite returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(*returnedInvite, invite) {
+		t.Errorf("Customer.SendInvite returned %+v, expected %+v", returnedInvite, invite)
+	}
+}
+
+func TestCustomerAccountActivationURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers/1/account_activation_url.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"account_activation_url":"https://jsmith.myshopify.com/account/activate/1/a1b2c3-1234567890"}`))
+
+	activationURL, err := client.Customer.AccountActivationURL(1)
+	if err != nil {
+		t.Errorf("Customer.AccountActivationURL returned error: %v", err)
+	}
+
+	expected := "https://jsmith.myshopify.com/account/activate/1/a1b2c3-1234567890"
+	if activationURL != expected {
+		t.Errorf("Customer.AccountActivationURL returned %s, expected %s", activationURL, expected)
+	}
+}
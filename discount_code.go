@@ -7,7 +7,30 @@ import (
 	"time"
 )
 
-const discountCodeBasePath = "price_rules/%d/discount_codes"
+const (
+	discountCodeBasePath      = "price_rules/%d/discount_codes"
+	discountCodeBatchBasePath = "price_rules/%d/batch"
+
+	// DiscountCodeBatchMaxSize is the maximum number of codes Shopify accepts
+	// in a single batch job.
+	DiscountCodeBatchMaxSize = 100
+)
+
+// Statuses of a discount code creation job.
+const (
+	DiscountCodeCreationQueued    = "queued"
+	DiscountCodeCreationRunning   = "running"
+	DiscountCodeCreationCompleted = "completed"
+)
+
+var (
+	// discountCodeBatchPollInterval is the time waited between two polls of
+	// a batch job.
+	discountCodeBatchPollInterval = time.Second
+
+	// discountCodeBatchTimeout is the time a batch job has to complete.
+	discountCodeBatchTimeout = 5 * time.Minute
+)
 
 // DiscountCodeService is an interface for interfacing with the discount endpoints
 // of the Shopify API.
@@ -19,6 +42,11 @@ type DiscountCodeService interface {
 	ListWithPagination(int64, interface{}) ([]PriceRuleDiscountCode, *Pagination, error)
 	Get(int64, int64) (*PriceRuleDiscountCode, error)
 	Delete(int64, int64) error
+	Count(interface{}) (int, error)
+	Lookup(string) (*PriceRuleDiscountCode, error)
+	CreateBatch(int64, []PriceRuleDiscountCode) ([]PriceRuleDiscountCode, error)
+	GetBatch(int64, int64) (*DiscountCodeCreation, error)
+	ListBatchDiscountCodes(int64, int64) ([]PriceRuleDiscountCode, error)
 	GetOrderList() []string
 }
 
@@ -36,6 +64,37 @@ type PriceRuleDiscountCode struct {
 	UsageCount  int        `json:"usage_count,omitempty"`
 	CreatedAt   *time.Time `json:"created_at,omitempty"`
 	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+
+	// Errors is only set on the results of a batch job, for codes that
+	// could not be created.
+	Errors map[string][]string `json:"errors,omitempty"`
+}
+
+// DiscountCodeCreation represents a batch job creating discount codes
+type DiscountCodeCreation struct {
+	ID            int64      `json:"id,omitempty"`
+	PriceRuleID   int64      `json:"price_rule_id,omitempty"`
+	StartedAt     *time.Time `json:"started_at,omitempty"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+	Status        string     `json:"status,omitempty"`
+	CodesCount    int        `json:"codes_count,omitempty"`
+	ImportedCount int        `json:"imported_count,omitempty"`
+	FailedCount   int        `json:"failed_count,omitempty"`
+	Logs          []string   `json:"logs,omitempty"`
+}
+
+// DiscountCodeCreationResource represents the result from the batch.json and
+// batch/X.json endpoints
+type DiscountCodeCreationResource struct {
+	DiscountCodeCreation *DiscountCodeCreation `json:"discount_code_creation"`
+}
+
+// discountCodeLookupOptions are the query parameters of the
+// discount_codes/lookup.json endpoint
+type discountCodeLookupOptions struct {
+	Code string `url:"code"`
 }
 
 // DiscountCodesResource is the result from the discount_codes.json endpoint
@@ -108,6 +167,103 @@ func (s *DiscountCodeServiceOp) Delete(priceRuleID int64, discountCodeID int64)
 	return s.client.Delete(fmt.Sprintf(discountCodeBasePath+"/%d.json", priceRuleID, discountCodeID))
 }
 
+// Count discount codes of the shop, optionally filtered by times_used
+func (s *DiscountCodeServiceOp) Count(options interface{}) (int, error) {
+	return s.client.Count("discount_codes/count.json", options)
+}
+
+// Lookup a discount code by its code. Shopify responds with a redirect to the
+// discount code under its price rule, which is followed by the http client.
+func (s *DiscountCodeServiceOp) Lookup(code string) (*PriceRuleDiscountCode, error) {
+	resource := new(DiscountCodeResource)
+	err := s.client.Get("discount_codes/lookup.json", resource, discountCodeLookupOptions{Code: code})
+	return resource.PriceRuleDiscountCode, err
+}
+
+// CreateBatch creates discount codes with batch jobs of at most
+// DiscountCodeBatchMaxSize codes and waits for each job to complete. It
+// returns the result of every code; codes that could not be created have
+// their Errors set.
+func (s *DiscountCodeServiceOp) CreateBatch(priceRuleID int64, codes []PriceRuleDiscountCode) ([]PriceRuleDiscountCode, error) {
+	results := make([]PriceRuleDiscountCode, 0, len(codes))
+	for start := 0; start < len(codes); start += DiscountCodeBatchMaxSize {
+		end := start + DiscountCodeBatchMaxSize
+		if end > len(codes) {
+			end = len(codes)
+		}
+
+		batch, err := s.startBatch(priceRuleID, codes[start:end])
+		if err != nil {
+			return results, err
+		}
+
+		batch, err = s.waitBatch(priceRuleID, batch)
+		if err != nil {
+			return results, err
+		}
+
+		batchCodes, err := s.ListBatchDiscountCodes(priceRuleID, batch.ID)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, batchCodes...)
+	}
+	return results, nil
+}
+
+// startBatch creates a batch job for at most DiscountCodeBatchMaxSize codes
+func (s *DiscountCodeServiceOp) startBatch(priceRuleID int64, codes []PriceRuleDiscountCode) (*DiscountCodeCreation, error) {
+	path := fmt.Sprintf(discountCodeBatchBasePath+".json", priceRuleID)
+	wrappedData := DiscountCodesResource{DiscountCodes: codes}
+	resource := new(DiscountCodeCreationResource)
+	if err := s.client.Post(path, wrappedData, resource); err != nil {
+		return nil, err
+	}
+	if resource.DiscountCodeCreation == nil {
+		return nil, fmt.Errorf("discount code batch of price rule %d was not returned", priceRuleID)
+	}
+	return resource.DiscountCodeCreation, nil
+}
+
+// waitBatch polls a batch job until it is completed
+func (s *DiscountCodeServiceOp) waitBatch(priceRuleID int64, batch *DiscountCodeCreation) (*DiscountCodeCreation, error) {
+	deadline := time.Now().Add(discountCodeBatchTimeout)
+	for batch.Status != DiscountCodeCreationCompleted {
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("discount code batch %d did not complete within %s", batch.ID, discountCodeBatchTimeout)
+		}
+		time.Sleep(discountCodeBatchPollInterval)
+
+		batchID := batch.ID
+		var err error
+		batch, err = s.GetBatch(priceRuleID, batchID)
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			return nil, fmt.Errorf("discount code batch %d was not returned", batchID)
+		}
+	}
+	return batch, nil
+}
+
+// GetBatch gets a discount code creation job
+func (s *DiscountCodeServiceOp) GetBatch(priceRuleID int64, batchID int64) (*DiscountCodeCreation, error) {
+	path := fmt.Sprintf(discountCodeBatchBasePath+"/%d.json", priceRuleID, batchID)
+	resource := new(DiscountCodeCreationResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.DiscountCodeCreation, err
+}
+
+// ListBatchDiscountCodes lists the results of a discount code creation job.
+// Codes that could not be created have no ID and their Errors set.
+func (s *DiscountCodeServiceOp) ListBatchDiscountCodes(priceRuleID int64, batchID int64) ([]PriceRuleDiscountCode, error) {
+	path := fmt.Sprintf(discountCodeBatchBasePath+"/%d/discount_codes.json", priceRuleID, batchID)
+	resource := new(DiscountCodesResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.DiscountCodes, err
+}
+
 func (s *DiscountCodeServiceOp) GetOrderList() []string {
 	str := new(PriceRuleDiscountCode)
 
@@ -1,8 +1,12 @@
 package goshopify
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 )
@@ -128,3 +132,159 @@ func TestDiscountCodeDelete(t *testing.T) {
 		t.Errorf("DiscountCode.Delete returned error: %v", err)
 	}
 }
+
+func TestDiscountCodeCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/discount_codes/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 3}`),
+	)
+
+	cnt, err := client.DiscountCode.Count(nil)
+	if err != nil {
+		t.Errorf("DiscountCode.Count returned error: %v", err)
+	}
+
+	expected := 3
+	if cnt != expected {
+		t.Errorf("DiscountCode.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestDiscountCodeLookup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"code": "SUMMERSALE10OFF"}
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/discount_codes/lookup.json", client.pathPrefix),
+		params,
+		httpmock.ResponderFromResponse(&http.Response{
+			StatusCode: http.StatusSeeOther,
+			Body:       httpmock.NewRespBodyFromString(""),
+			Header: http.Header{
+				"Location": {fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/discount_codes/507328175", client.pathPrefix)},
+			},
+		}),
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/discount_codes/507328175", client.pathPrefix),
+		httpmock.NewStringResponder(
+			200,
+			`{"discount_code":{"id":507328175,"price_rule_id":507328175,"code":"SUMMERSALE10OFF","usage_count":0,"created_at":"2018-07-05T12:41:00-04:00","updated_at":"2018-07-05T12:41:00-04:00"}}`,
+		),
+	)
+
+	dc, err := client.DiscountCode.Lookup("SUMMERSALE10OFF")
+	if err != nil {
+		t.Fatalf("DiscountCode.Lookup returned error: %v", err)
+	}
+
+	if dc.ID != 507328175 || dc.PriceRuleID != 507328175 || dc.Code != "SUMMERSALE10OFF" {
+		t.Errorf("DiscountCode.Lookup returned %+v, expected discount code 507328175", dc)
+	}
+}
+
+func TestDiscountCodeCreateBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	discountCodeBatchPollInterval = 0
+	defer func() { discountCodeBatchPollInterval = time.Second }()
+
+	batchURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/batch", client.pathPrefix)
+
+	var batchSizes []int
+	httpmock.RegisterResponder("POST", batchURL+".json",
+		func(req *http.Request) (*http.Response, error) {
+			resource := new(DiscountCodesResource)
+			if err := json.NewDecoder(req.Body).Decode(resource); err != nil {
+				return nil, err
+			}
+			batchSizes = append(batchSizes, len(resource.DiscountCodes))
+			body := fmt.Sprintf(`{"discount_code_creation":{"id":%d,"price_rule_id":507328175,"status":"queued","codes_count":%d}}`,
+				len(batchSizes), len(resource.DiscountCodes))
+			return httpmock.NewStringResponse(201, body), nil
+		})
+
+	polls := 0
+	httpmock.RegisterResponder("GET", batchURL+"/1.json",
+		func(req *http.Request) (*http.Response, error) {
+			polls++
+			status := DiscountCodeCreationRunning
+			if polls > 1 {
+				status = DiscountCodeCreationCompleted
+			}
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"discount_code_creation":{"id":1,"status":"%s"}}`, status)), nil
+		})
+	httpmock.RegisterResponder("GET", batchURL+"/2.json",
+		httpmock.NewStringResponder(200, `{"discount_code_creation":{"id":2,"status":"completed"}}`))
+
+	httpmock.RegisterResponder("GET", batchURL+"/1/discount_codes.json",
+		httpmock.NewStringResponder(200, `{"discount_codes":[{"id":1,"code":"CODE0","errors":{}},{"id":null,"code":"CODE1","errors":{"code":["must be unique. Please try a different code."]}}]}`))
+	httpmock.RegisterResponder("GET", batchURL+"/2/discount_codes.json",
+		httpmock.NewStringResponder(200, `{"discount_codes":[{"id":3,"code":"CODE100","errors":{}}]}`))
+
+	codes := make([]PriceRuleDiscountCode, 150)
+	for i := range codes {
+		codes[i].Code = fmt.Sprintf("CODE%d", i)
+	}
+
+	results, err := client.DiscountCode.CreateBatch(507328175, codes)
+	if err != nil {
+		t.Fatalf("DiscountCode.CreateBatch returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(batchSizes, []int{100, 50}) {
+		t.Errorf("DiscountCode.CreateBatch sent batches of %v, expected [100 50]", batchSizes)
+	}
+
+	if polls != 2 {
+		t.Errorf("DiscountCode.CreateBatch polled batch 1 %d times, expected 2", polls)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("DiscountCode.CreateBatch returned %d results, expected 3", len(results))
+	}
+
+	if len(results[0].Errors) != 0 || results[0].ID != 1 {
+		t.Errorf("DiscountCode.CreateBatch result %+v, expected a created code", results[0])
+	}
+
+	expectedErrors := map[string][]string{"code": {"must be unique. Please try a different code."}}
+	if results[1].ID != 0 || !reflect.DeepEqual(results[1].Errors, expectedErrors) {
+		t.Errorf("DiscountCode.CreateBatch result %+v, expected errors %v", results[1], expectedErrors)
+	}
+}
+
+func TestDiscountCodeCreateBatchMissingBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	discountCodeBatchPollInterval = 0
+	defer func() { discountCodeBatchPollInterval = time.Second }()
+
+	batchURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/batch", client.pathPrefix)
+	codes := []PriceRuleDiscountCode{{Code: "CODE0"}}
+
+	httpmock.RegisterResponder("POST", batchURL+".json", httpmock.NewStringResponder(201, `{}`))
+
+	_, err := client.DiscountCode.CreateBatch(507328175, codes)
+	if err == nil {
+		t.Errorf("DiscountCode.CreateBatch expected an error when no batch is returned")
+	}
+
+	httpmock.RegisterResponder("POST", batchURL+".json",
+		httpmock.NewStringResponder(201, `{"discount_code_creation":{"id":1,"status":"queued"}}`))
+	httpmock.RegisterResponder("GET", batchURL+"/1.json", httpmock.NewStringResponder(200, `{}`))
+
+	_, err = client.DiscountCode.CreateBatch(507328175, codes)
+	if err == nil {
+		t.Errorf("DiscountCode.CreateBatch expected an error when the polled batch is not returned")
+	}
+}
@@ -11,9 +11,38 @@ import (
 
 const disputesBasePath = "shopify_payments/disputes"
 
+// Statuses of a dispute.
+const (
+	DisputeStatusNeedsResponse  = "needs_response"
+	DisputeStatusUnderReview    = "under_review"
+	DisputeStatusChargeRefunded = "charge_refunded"
+	DisputeStatusAccepted       = "accepted"
+	DisputeStatusWon            = "won"
+	DisputeStatusLost           = "lost"
+)
+
+// Document types of the files supporting a dispute evidence.
+const (
+	DisputeDocumentCancellationPolicy    = "cancellation_policy_file"
+	DisputeDocumentCustomerCommunication = "customer_communication_file"
+	DisputeDocumentRefundPolicy          = "refund_policy_file"
+	DisputeDocumentServiceDocumentation  = "service_documentation_file"
+	DisputeDocumentShippingDocumentation = "shipping_documentation_file"
+	DisputeDocumentUncategorized         = "uncategorized_file"
+)
+
+// DisputeService is an interface for interfacing with the Shopify Payments
+// dispute endpoints of the Shopify API.
+// See: https://shopify.dev/docs/admin-api/rest/reference/shopify_payments/dispute
 type DisputeService interface {
 	List(interface{}) ([]Dispute, error)
 	ListWithPagination(interface{}) ([]Dispute, *Pagination, error)
+	Get(int64) (*Dispute, error)
+	GetEvidence(int64) (*DisputeEvidence, error)
+	UpdateEvidence(int64, DisputeEvidence) (*DisputeEvidence, error)
+	SubmitEvidence(int64) (*DisputeEvidence, error)
+	UploadFile(int64, DisputeFileUpload) (*DisputeFileUpload, error)
+	DeleteFile(int64, int64) error
 	GetOrderList() []string
 }
 
@@ -21,7 +50,7 @@ type DisputeServiceOp struct {
 	client *Client
 }
 
-// Dispute represents a Shopify blog
+// Dispute represents a Shopify Payments dispute
 type Dispute struct {
 	ID                int64            `json:"id"`
 	OrderID           int64            `json:"order_id"`
@@ -37,6 +66,101 @@ type Dispute struct {
 	InitiatedAt       *time.Time       `json:"initiated_at"`
 }
 
+// EvidenceOpen returns true if evidence can still be sent for the dispute at
+// the given time.
+func (d Dispute) EvidenceOpen(now time.Time) bool {
+	return d.Status == DisputeStatusNeedsResponse && d.EvidenceDueBy != nil && now.Before(*d.EvidenceDueBy)
+}
+
+// TimeUntilEvidenceDue returns the time left at the given time to send
+// evidence for the dispute. It is negative once the deadline passed and zero
+// when the dispute has no deadline.
+func (d Dispute) TimeUntilEvidenceDue(now time.Time) time.Duration {
+	if d.EvidenceDueBy == nil {
+		return 0
+	}
+	return d.EvidenceDueBy.Sub(now)
+}
+
+// DisputeEvidence represents the evidence a merchant sends to the bank to
+// challenge a dispute. Only the non empty fields are sent on update.
+type DisputeEvidence struct {
+	ID                           int64                      `json:"id,omitempty"`
+	PaymentsDisputeID            int64                      `json:"payments_dispute_id,omitempty"`
+	AccessActivityLog            string                     `json:"access_activity_log,omitempty"`
+	CancellationPolicyDisclosure string                     `json:"cancellation_policy_disclosure,omitempty"`
+	CancellationRebuttal         string                     `json:"cancellation_rebuttal,omitempty"`
+	CustomerEmailAddress         string                     `json:"customer_email_address,omitempty"`
+	CustomerFirstName            string                     `json:"customer_first_name,omitempty"`
+	CustomerLastName             string                     `json:"customer_last_name,omitempty"`
+	CustomerPurchaseIP           string                     `json:"customer_purchase_ip,omitempty"`
+	RefundPolicyDisclosure       string                     `json:"refund_policy_disclosure,omitempty"`
+	RefundRefusalExplanation     string                     `json:"refund_refusal_explanation,omitempty"`
+	UncategorizedText            string                     `json:"uncategorized_text,omitempty"`
+	ProductDescription           *DisputeProductDescription `json:"product_description,omitempty"`
+	ShippingAddress              *Address                   `json:"shipping_address,omitempty"`
+	BillingAddress               *Address                   `json:"billing_address,omitempty"`
+	Fulfillments                 []DisputeFulfillment       `json:"fulfillments,omitempty"`
+	DisputeFileUploads           []DisputeFileUpload        `json:"dispute_file_uploads,omitempty"`
+	SubmitEvidence               bool                       `json:"submit_evidence,omitempty"`
+	SubmittedByMerchantOn        *time.Time                 `json:"submitted_by_merchant_on,omitempty"`
+	CreatedAt                    *time.Time                 `json:"created_at,omitempty"`
+	UpdatedAt                    *time.Time                 `json:"updated_at,omitempty"`
+}
+
+// DisputeProductDescription describes the disputed product
+type DisputeProductDescription struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Price       string `json:"price,omitempty"`
+	Quantity    int    `json:"quantity,omitempty"`
+	SKU         string `json:"sku,omitempty"`
+	Brand       string `json:"brand,omitempty"`
+	Condition   string `json:"condition,omitempty"`
+}
+
+// DisputeFulfillment is the shipping information of a disputed order.
+// ShippingDate is formatted as 2006-01-02.
+type DisputeFulfillment struct {
+	ShippingCarrier        string `json:"shipping_carrier,omitempty"`
+	ShippingTrackingNumber string `json:"shipping_tracking_number,omitempty"`
+	ShippingDate           string `json:"shipping_date,omitempty"`
+}
+
+// DisputeFileUpload is a file supporting a dispute evidence. DocumentType,
+// Filename, Mimetype and Data are sent to upload a file, Data is base64
+// encoded when sent. The other fields are returned by Shopify.
+type DisputeFileUpload struct {
+	ID                  int64  `json:"id,omitempty"`
+	DisputeEvidenceType string `json:"dispute_evidence_type,omitempty"`
+	FileSize            int64  `json:"file_size,omitempty"`
+	FileType            string `json:"file_type,omitempty"`
+	OriginalFileName    string `json:"original_file_name,omitempty"`
+	URL                 string `json:"url,omitempty"`
+
+	DocumentType string `json:"document_type,omitempty"`
+	Filename     string `json:"filename,omitempty"`
+	Mimetype     string `json:"mimetype,omitempty"`
+	Data         []byte `json:"data,omitempty"`
+}
+
+// DisputeResource is the result from the disputes/X.json endpoint
+type DisputeResource struct {
+	Dispute *Dispute `json:"dispute"`
+}
+
+// DisputeEvidenceResource is the result from the
+// disputes/X/dispute_evidences.json endpoint
+type DisputeEvidenceResource struct {
+	DisputeEvidence *DisputeEvidence `json:"dispute_evidence"`
+}
+
+// DisputeFileUploadResource is the result from the
+// disputes/X/dispute_file_uploads.json endpoint
+type DisputeFileUploadResource struct {
+	DisputeFileUpload *DisputeFileUpload `json:"dispute_file_upload"`
+}
+
 // DisputesResource is the result from the disputes.json endpoint
 type DisputesResource struct {
 	Disputes []Dispute `json:"disputes"`
@@ -71,6 +195,52 @@ func (s *DisputeServiceOp) ListWithPagination(options interface{}) ([]Dispute, *
 	return resource.Disputes, pagination, nil
 }
 
+// Get a single dispute
+func (s *DisputeServiceOp) Get(disputeID int64) (*Dispute, error) {
+	path := fmt.Sprintf("%s/%d.json", disputesBasePath, disputeID)
+	resource := new(DisputeResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.Dispute, err
+}
+
+// GetEvidence gets the evidence of a dispute
+func (s *DisputeServiceOp) GetEvidence(disputeID int64) (*DisputeEvidence, error) {
+	path := fmt.Sprintf("%s/%d/dispute_evidences.json", disputesBasePath, disputeID)
+	resource := new(DisputeEvidenceResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.DisputeEvidence, err
+}
+
+// UpdateEvidence updates the evidence of a dispute. The evidence is sent to
+// the bank when SubmitEvidence is set.
+func (s *DisputeServiceOp) UpdateEvidence(disputeID int64, evidence DisputeEvidence) (*DisputeEvidence, error) {
+	path := fmt.Sprintf("%s/%d/dispute_evidences.json", disputesBasePath, disputeID)
+	wrappedData := DisputeEvidenceResource{DisputeEvidence: &evidence}
+	resource := new(DisputeEvidenceResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.DisputeEvidence, err
+}
+
+// SubmitEvidence sends the evidence of a dispute to the bank. The evidence
+// cannot be updated afterwards.
+func (s *DisputeServiceOp) SubmitEvidence(disputeID int64) (*DisputeEvidence, error) {
+	return s.UpdateEvidence(disputeID, DisputeEvidence{SubmitEvidence: true})
+}
+
+// UploadFile uploads a file supporting the evidence of a dispute
+func (s *DisputeServiceOp) UploadFile(disputeID int64, file DisputeFileUpload) (*DisputeFileUpload, error) {
+	path := fmt.Sprintf("%s/%d/dispute_file_uploads.json", disputesBasePath, disputeID)
+	wrappedData := DisputeFileUploadResource{DisputeFileUpload: &file}
+	resource := new(DisputeFileUploadResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.DisputeFileUpload, err
+}
+
+// DeleteFile deletes a file supporting the evidence of a dispute
+func (s *DisputeServiceOp) DeleteFile(disputeID int64, fileID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d/dispute_file_uploads/%d.json", disputesBasePath, disputeID, fileID))
+}
+
 func (s *DisputeServiceOp) GetOrderList() []string {
 	str := new(Dispute)
 
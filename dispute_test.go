@@ -0,0 +1,154 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestDisputeGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes/598735659.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"dispute":{"id":598735659,"order_id":625362839,"type":"chargeback","amount":"11.50","currency":"USD","reason":"fraudulent","network_reason_code":4827,"status":"needs_response","evidence_due_by":"2013-07-03T19:00:00-04:00","evidence_sent_on":null,"finalized_on":null,"initiated_at":"2013-05-03T20:00:00-04:00"}}`))
+
+	dispute, err := client.Dispute.Get(598735659)
+	if err != nil {
+		t.Fatalf("Dispute.Get returned error: %v", err)
+	}
+
+	if dispute.ID != 598735659 || dispute.Status != DisputeStatusNeedsResponse {
+		t.Errorf("Dispute.Get returned %+v, expected dispute 598735659", dispute)
+	}
+
+	dueBy := time.Date(2013, time.July, 3, 23, 0, 0, 0, time.UTC)
+	if dispute.EvidenceDueBy == nil || !dispute.EvidenceDueBy.Equal(dueBy) {
+		t.Errorf("Dispute.EvidenceDueBy returned %v, expected %v", dispute.EvidenceDueBy, dueBy)
+	}
+
+	now := dueBy.Add(-48 * time.Hour)
+	if !dispute.EvidenceOpen(now) {
+		t.Errorf("Dispute.EvidenceOpen returned false two days before the deadline")
+	}
+	if left := dispute.TimeUntilEvidenceDue(now); left != 48*time.Hour {
+		t.Errorf("Dispute.TimeUntilEvidenceDue returned %v, expected 48h", left)
+	}
+	if dispute.EvidenceOpen(dueBy.Add(time.Second)) {
+		t.Errorf("Dispute.EvidenceOpen returned true after the deadline")
+	}
+}
+
+func TestDisputeGetEvidence(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes/598735659/dispute_evidences.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"dispute_evidence":{"id":819974671,"payments_dispute_id":598735659,"refund_policy_disclosure":"Refund policy disclosed","customer_first_name":"Kermit","fulfillments":[{"shipping_carrier":"UPS","shipping_tracking_number":"1234","shipping_date":"2017-01-01"}],"dispute_file_uploads":[{"id":799719586,"dispute_evidence_type":"refund_policy_file","file_size":123,"file_type":"image/jpeg","original_file_name":"refund_policy.jpeg","url":"https://example.com/refund_policy.jpeg"}],"submitted_by_merchant_on":null}}`))
+
+	evidence, err := client.Dispute.GetEvidence(598735659)
+	if err != nil {
+		t.Fatalf("Dispute.GetEvidence returned error: %v", err)
+	}
+
+	if evidence.ID != 819974671 || evidence.RefundPolicyDisclosure != "Refund policy disclosed" {
+		t.Errorf("Dispute.GetEvidence returned %+v, expected evidence 819974671", evidence)
+	}
+	if len(evidence.Fulfillments) != 1 || evidence.Fulfillments[0].ShippingCarrier != "UPS" {
+		t.Errorf("DisputeEvidence.Fulfillments returned %+v, expected the UPS fulfillment", evidence.Fulfillments)
+	}
+	if len(evidence.DisputeFileUploads) != 1 || evidence.DisputeFileUploads[0].DisputeEvidenceType != DisputeDocumentRefundPolicy {
+		t.Errorf("DisputeEvidence.DisputeFileUploads returned %+v, expected the refund policy file", evidence.DisputeFileUploads)
+	}
+}
+
+func TestDisputeUpdateAndSubmitEvidence(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent []map[string]map[string]interface{}
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes/598735659/dispute_evidences.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			body := map[string]map[string]interface{}{}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			sent = append(sent, body)
+			return httpmock.NewStringResponse(200, `{"dispute_evidence":{"id":819974671,"payments_dispute_id":598735659,"refund_policy_disclosure":"Refund policy disclosed"}}`), nil
+		})
+
+	evidence, err := client.Dispute.UpdateEvidence(598735659, DisputeEvidence{
+		RefundPolicyDisclosure: "Refund policy disclosed",
+		Fulfillments:           []DisputeFulfillment{{ShippingCarrier: "UPS", ShippingTrackingNumber: "1234", ShippingDate: "2017-01-01"}},
+	})
+	if err != nil {
+		t.Fatalf("Dispute.UpdateEvidence returned error: %v", err)
+	}
+	if evidence.ID != 819974671 {
+		t.Errorf("DisputeEvidence.ID returned %d, expected 819974671", evidence.ID)
+	}
+
+	_, err = client.Dispute.SubmitEvidence(598735659)
+	if err != nil {
+		t.Fatalf("Dispute.SubmitEvidence returned error: %v", err)
+	}
+
+	if len(sent) != 2 {
+		t.Fatalf("Dispute evidence was updated %d times, expected 2", len(sent))
+	}
+	if sent[0]["dispute_evidence"]["refund_policy_disclosure"] != "Refund policy disclosed" || sent[0]["dispute_evidence"]["submit_evidence"] != nil {
+		t.Errorf("Dispute.UpdateEvidence sent %+v", sent[0])
+	}
+	if len(sent[1]["dispute_evidence"]) != 1 || sent[1]["dispute_evidence"]["submit_evidence"] != true {
+		t.Errorf("Dispute.SubmitEvidence sent %+v, expected only submit_evidence", sent[1])
+	}
+}
+
+func TestDisputeUploadFile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent map[string]map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes/598735659/dispute_file_uploads.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, `{"dispute_file_upload":{"id":799719586,"dispute_evidence_type":"refund_policy_file","file_size":3,"file_type":"application/pdf","original_file_name":"refund_policy.pdf","url":"https://example.com/refund_policy.pdf"}}`), nil
+		})
+
+	file, err := client.Dispute.UploadFile(598735659, DisputeFileUpload{
+		DocumentType: DisputeDocumentRefundPolicy,
+		Filename:     "refund_policy.pdf",
+		Mimetype:     "application/pdf",
+		Data:         []byte("pdf"),
+	})
+	if err != nil {
+		t.Fatalf("Dispute.UploadFile returned error: %v", err)
+	}
+
+	if file.ID != 799719586 || file.OriginalFileName != "refund_policy.pdf" {
+		t.Errorf("Dispute.UploadFile returned %+v, expected file 799719586", file)
+	}
+
+	if sent["dispute_file_upload"]["data"] != "cGRm" || sent["dispute_file_upload"]["document_type"] != DisputeDocumentRefundPolicy {
+		t.Errorf("Dispute.UploadFile sent %+v", sent)
+	}
+}
+
+func TestDisputeDeleteFile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes/598735659/dispute_file_uploads/799719586.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Dispute.DeleteFile(598735659, 799719586)
+	if err != nil {
+		t.Errorf("Dispute.DeleteFile returned error: %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"time"
@@ -18,6 +19,8 @@ type FulfillmentService interface {
 	Complete(int64) (*Fulfillment, error)
 	Transition(int64) (*Fulfillment, error)
 	Cancel(int64) (*Fulfillment, error)
+	CreateForFulfillmentOrders(FulfillmentForFulfillmentOrders) (*Fulfillment, error)
+	UpdateTracking(int64, FulfillmentTrackingInfo, bool) (*Fulfillment, error)
 	GetOrderList() []string
 }
 
@@ -65,10 +68,54 @@ type Fulfillment struct {
 	NotifyCustomer    bool        `json:"notify_customer,omitempty"`
 }
 
-// Receipt represents a Shopify receipt.
+// Receipt represents a Shopify receipt. Receipts are gateway specific, every
+// field of the receipt is available in Fields.
 type Receipt struct {
 	TestCase      bool   `json:"testcase"`
 	Authorization string `json:"authorization"`
+
+	Fields map[string]interface{} `json:"-"`
+}
+
+// UnmarshalJSON decodes the known fields of a receipt and keeps all of them in
+// Fields.
+func (r *Receipt) UnmarshalJSON(data []byte) error {
+	type alias Receipt
+	known := alias{}
+	if err := json.Unmarshal(data, &known); err != nil {
+		return err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	*r = Receipt(known)
+	r.Fields = fields
+	return nil
+}
+
+// FulfillmentTrackingInfo represents the tracking information of a fulfillment
+// created from fulfillment orders.
+type FulfillmentTrackingInfo struct {
+	Number  string `json:"number,omitempty"`
+	URL     string `json:"url,omitempty"`
+	Company string `json:"company,omitempty"`
+}
+
+// FulfillmentOrderLineItems selects the line items of a fulfillment order to
+// fulfill. Leaving FulfillmentOrderLineItems empty fulfills all of them.
+type FulfillmentOrderLineItems struct {
+	FulfillmentOrderID        int64                              `json:"fulfillment_order_id"`
+	FulfillmentOrderLineItems []FulfillmentOrderLineItemQuantity `json:"fulfillment_order_line_items,omitempty"`
+}
+
+// FulfillmentForFulfillmentOrders is used to create a fulfillment for one or
+// more fulfillment orders.
+type FulfillmentForFulfillmentOrders struct {
+	Message                     string                      `json:"message,omitempty"`
+	NotifyCustomer              bool                        `json:"notify_customer"`
+	TrackingInfo                *FulfillmentTrackingInfo    `json:"tracking_info,omitempty"`
+	LineItemsByFulfillmentOrder []FulfillmentOrderLineItems `json:"line_items_by_fulfillment_order"`
 }
 
 // FulfillmentResource represents the result from the fulfillments/X.json endpoint
@@ -153,6 +200,31 @@ func (s *FulfillmentServiceOp) Cancel(fulfillmentID int64) (*Fulfillment, error)
 	return resource.Fulfillment, err
 }
 
+// CreateForFulfillmentOrders creates a fulfillment for the given fulfillment
+// order line items. Unlike Create, it does not depend on an order and always
+// uses the fulfillments.json endpoint.
+func (s *FulfillmentServiceOp) CreateForFulfillmentOrders(fulfillment FulfillmentForFulfillmentOrders) (*Fulfillment, error) {
+	path := fmt.Sprintf("%s.json", FulfillmentPathPrefix("", 0))
+	wrappedData := map[string]interface{}{"fulfillment": fulfillment}
+	resource := new(FulfillmentResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Fulfillment, err
+}
+
+// UpdateTracking updates the tracking information of a fulfillment
+func (s *FulfillmentServiceOp) UpdateTracking(fulfillmentID int64, trackingInfo FulfillmentTrackingInfo, notifyCustomer bool) (*Fulfillment, error) {
+	path := fmt.Sprintf("%s/%d/update_tracking.json", FulfillmentPathPrefix("", 0), fulfillmentID)
+	wrappedData := map[string]interface{}{
+		"fulfillment": map[string]interface{}{
+			"notify_customer": notifyCustomer,
+			"tracking_info":   trackingInfo,
+		},
+	}
+	resource := new(FulfillmentResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Fulfillment, err
+}
+
 func (s *FulfillmentServiceOp) GetOrderList() []string {
 	str := new(Fulfillment)
 
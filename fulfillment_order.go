@@ -11,9 +11,38 @@ const (
 	ordersFulfillmentOrdersBasePath = "orders/%d/fulfillment_orders"
 )
 
+// Actions a fulfillment order can support, as listed in its SupportedActions.
+const (
+	FulfillmentOrderActionCreateFulfillment   = "create_fulfillment"
+	FulfillmentOrderActionRequestFulfillment  = "request_fulfillment"
+	FulfillmentOrderActionCancel              = "cancel_fulfillment_order"
+	FulfillmentOrderActionRequestCancellation = "request_cancellation"
+	FulfillmentOrderActionMove                = "move"
+	FulfillmentOrderActionHold                = "hold"
+	FulfillmentOrderActionReleaseHold         = "release_hold"
+	FulfillmentOrderActionMarkAsOpen          = "mark_as_open"
+)
+
+// FulfillmentOrderService is an interface for interfacing with the fulfillment
+// order endpoints of the Shopify API.
+// See: https://shopify.dev/api/admin-rest/latest/resources/fulfillmentorder
 type FulfillmentOrderService interface {
 	Get(int64, interface{}) (*FulfillmentOrder, error)
 	List(int64, interface{}) ([]FulfillmentOrder, error)
+	Cancel(FulfillmentOrder) (*FulfillmentOrder, error)
+	Close(FulfillmentOrder, string) (*FulfillmentOrder, error)
+	Hold(FulfillmentOrder, FulfillmentHold) (*FulfillmentOrder, error)
+	ReleaseHold(FulfillmentOrder) (*FulfillmentOrder, error)
+	Move(FulfillmentOrder, int64) (*FulfillmentOrderMove, error)
+	Open(FulfillmentOrder) (*FulfillmentOrder, error)
+	Reschedule(FulfillmentOrder, time.Time) (*FulfillmentOrder, error)
+	SetFulfillmentOrdersDeadline([]int64, time.Time) error
+	SendFulfillmentRequest(FulfillmentOrder, FulfillmentRequest) (*FulfillmentRequestResult, error)
+	AcceptFulfillmentRequest(FulfillmentOrder, string) (*FulfillmentOrder, error)
+	RejectFulfillmentRequest(FulfillmentOrder, FulfillmentRequestRejection) (*FulfillmentOrder, error)
+	SendCancellationRequest(FulfillmentOrder, string) (*FulfillmentOrder, error)
+	AcceptCancellationRequest(FulfillmentOrder, string) (*FulfillmentOrder, error)
+	RejectCancellationRequest(FulfillmentOrder, string) (*FulfillmentOrder, error)
 	GetOrderList() []string
 }
 
@@ -34,6 +63,78 @@ type FulfillmentOrder struct {
 	FulfillmentAerviceHandle string             `json:"fulfillment_service_handle"`
 	AssignedLocation         *Location          `json:"assigned_location"`
 	MerchantRequests         []*MerchantRequest `json:"merchant_requests"`
+	FulfillAt                *time.Time         `json:"fulfill_at"`
+	FulfillBy                *time.Time         `json:"fulfill_by"`
+	FulfillmentHolds         []*FulfillmentHold `json:"fulfillment_holds"`
+	CreatedAt                *time.Time         `json:"created_at"`
+	UpdatedAt                *time.Time         `json:"updated_at"`
+}
+
+// SupportsAction returns true if action is one of the fulfillment order's
+// SupportedActions.
+func (fo FulfillmentOrder) SupportsAction(action string) bool {
+	for _, supported := range fo.SupportedActions {
+		if supported == action {
+			return true
+		}
+	}
+	return false
+}
+
+// FulfillmentOrderActionError is returned when a fulfillment order does not
+// allow an operation, either because the action is not one of its
+// SupportedActions or because it is not in the required status.
+type FulfillmentOrderActionError struct {
+	FulfillmentOrderID int64
+	Action             string
+	Message            string
+}
+
+func (e FulfillmentOrderActionError) Error() string {
+	return fmt.Sprintf("fulfillment order %d: cannot %s: %s", e.FulfillmentOrderID, e.Action, e.Message)
+}
+
+// FulfillmentHold represents the reason a fulfillment order is on hold
+type FulfillmentHold struct {
+	Reason         string `json:"reason"`
+	ReasonNotes    string `json:"reason_notes,omitempty"`
+	NotifyMerchant bool   `json:"notify_merchant,omitempty"`
+}
+
+// FulfillmentOrderMove is the result of moving a fulfillment order to a new
+// location.
+type FulfillmentOrderMove struct {
+	OriginalFulfillmentOrder  *FulfillmentOrder `json:"original_fulfillment_order"`
+	MovedFulfillmentOrder     *FulfillmentOrder `json:"moved_fulfillment_order"`
+	RemainingFulfillmentOrder *FulfillmentOrder `json:"remaining_fulfillment_order"`
+}
+
+// FulfillmentOrderLineItemQuantity selects a quantity of a fulfillment order
+// line item.
+type FulfillmentOrderLineItemQuantity struct {
+	ID       int64 `json:"id"`
+	Quantity int   `json:"quantity"`
+}
+
+// FulfillmentRequest is sent to the fulfillment service of a fulfillment
+// order. Leaving FulfillmentOrderLineItems empty requests all line items.
+type FulfillmentRequest struct {
+	Message                   string                             `json:"message,omitempty"`
+	FulfillmentOrderLineItems []FulfillmentOrderLineItemQuantity `json:"fulfillment_order_line_items,omitempty"`
+}
+
+// FulfillmentRequestResult is the result of sending a fulfillment request.
+type FulfillmentRequestResult struct {
+	OriginalFulfillmentOrder    *FulfillmentOrder `json:"original_fulfillment_order"`
+	SubmittedFulfillmentOrder   *FulfillmentOrder `json:"submitted_fulfillment_order"`
+	UnsubmittedFulfillmentOrder *FulfillmentOrder `json:"unsubmitted_fulfillment_order"`
+}
+
+// FulfillmentRequestRejection is sent by a fulfillment service to reject a
+// fulfillment request.
+type FulfillmentRequestRejection struct {
+	Message string `json:"message,omitempty"`
+	Reason  string `json:"reason,omitempty"`
 }
 
 type Destination struct {
@@ -85,6 +186,230 @@ func (s *FulfillmentOrderServiceOp) List(orderID int64, options interface{}) ([]
 	return resource.FulfillmentOrders, err
 }
 
+// checkFulfillmentOrderAction returns a FulfillmentOrderActionError if action
+// is not one of the fulfillment order's SupportedActions.
+func checkFulfillmentOrderAction(fulfillmentOrder FulfillmentOrder, action string) error {
+	if fulfillmentOrder.SupportsAction(action) {
+		return nil
+	}
+	return FulfillmentOrderActionError{
+		FulfillmentOrderID: fulfillmentOrder.ID,
+		Action:             action,
+		Message:            fmt.Sprintf("supported actions are %v", fulfillmentOrder.SupportedActions),
+	}
+}
+
+// checkFulfillmentOrderStatus returns a FulfillmentOrderActionError if the
+// fulfillment order's status, or request status when requestStatus is true,
+// is not expected.
+func checkFulfillmentOrderStatus(fulfillmentOrder FulfillmentOrder, action string, requestStatus bool, expected string) error {
+	status := fulfillmentOrder.Status
+	field := "status"
+	if requestStatus {
+		status = fulfillmentOrder.RequestStatus
+		field = "request status"
+	}
+	if status == expected {
+		return nil
+	}
+	return FulfillmentOrderActionError{
+		FulfillmentOrderID: fulfillmentOrder.ID,
+		Action:             action,
+		Message:            fmt.Sprintf("%s is %q, expected %q", field, status, expected),
+	}
+}
+
+// postFulfillmentOrder posts data to an action endpoint of a fulfillment order
+// and returns the fulfillment order of the response.
+func (s *FulfillmentOrderServiceOp) postFulfillmentOrder(fulfillmentOrderID int64, action string, data interface{}) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf(fulfillmentOrdersBasePath+"/%s.json", fulfillmentOrderID, action)
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Post(path, data, resource)
+	return resource.FulfillmentOrder, err
+}
+
+// Cancel a fulfillment order
+func (s *FulfillmentOrderServiceOp) Cancel(fulfillmentOrder FulfillmentOrder) (*FulfillmentOrder, error) {
+	if err := checkFulfillmentOrderAction(fulfillmentOrder, FulfillmentOrderActionCancel); err != nil {
+		return nil, err
+	}
+	return s.postFulfillmentOrder(fulfillmentOrder.ID, "cancel", nil)
+}
+
+// Close marks an in progress fulfillment order as incomplete
+func (s *FulfillmentOrderServiceOp) Close(fulfillmentOrder FulfillmentOrder, message string) (*FulfillmentOrder, error) {
+	if err := checkFulfillmentOrderStatus(fulfillmentOrder, "close", false, "in_progress"); err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{
+		"fulfillment_order": map[string]string{"message": message},
+	}
+	return s.postFulfillmentOrder(fulfillmentOrder.ID, "close", data)
+}
+
+// Hold a fulfillment order
+func (s *FulfillmentOrderServiceOp) Hold(fulfillmentOrder FulfillmentOrder, hold FulfillmentHold) (*FulfillmentOrder, error) {
+	if err := checkFulfillmentOrderAction(fulfillmentOrder, FulfillmentOrderActionHold); err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{"fulfillment_hold": hold}
+	return s.postFulfillmentOrder(fulfillmentOrder.ID, "hold", data)
+}
+
+// ReleaseHold releases a fulfillment order on hold
+func (s *FulfillmentOrderServiceOp) ReleaseHold(fulfillmentOrder FulfillmentOrder) (*FulfillmentOrder, error) {
+	if err := checkFulfillmentOrderAction(fulfillmentOrder, FulfillmentOrderActionReleaseHold); err != nil {
+		return nil, err
+	}
+	return s.postFulfillmentOrder(fulfillmentOrder.ID, "release_hold", nil)
+}
+
+// checkFulfillmentOrderMove returns a FulfillmentOrderActionError if the
+// location is not one of the movable locations listed by the
+// LocationsForMoveService for the fulfillment order.
+func (s *FulfillmentOrderServiceOp) checkFulfillmentOrderMove(fulfillmentOrder FulfillmentOrder, locationID int64) error {
+	locations, err := s.client.LocationsForMove.List(fulfillmentOrder.ID, nil)
+	if err != nil {
+		return err
+	}
+
+	message := "location is not listed in the locations for move"
+	for _, location := range locations {
+		if location.Location == nil || location.Location.ID != locationID {
+			continue
+		}
+		if location.Movable {
+			return nil
+		}
+		message = fmt.Sprintf("location %s is not movable: %s", location.Location.Name, location.Message)
+		break
+	}
+	return FulfillmentOrderActionError{
+		FulfillmentOrderID: fulfillmentOrder.ID,
+		Action:             FulfillmentOrderActionMove,
+		Message:            fmt.Sprintf("cannot move to %d, %s", locationID, message),
+	}
+}
+
+// Move a fulfillment order to a new location. The location must be movable
+// according to the LocationsForMoveService, which is checked before moving.
+func (s *FulfillmentOrderServiceOp) Move(fulfillmentOrder FulfillmentOrder, newLocationID int64) (*FulfillmentOrderMove, error) {
+	if err := checkFulfillmentOrderAction(fulfillmentOrder, FulfillmentOrderActionMove); err != nil {
+		return nil, err
+	}
+	if err := s.checkFulfillmentOrderMove(fulfillmentOrder, newLocationID); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf(fulfillmentOrdersBasePath+"/move.json", fulfillmentOrder.ID)
+	data := map[string]interface{}{
+		"fulfillment_order": map[string]int64{"new_location_id": newLocationID},
+	}
+	resource := new(FulfillmentOrderMove)
+	err := s.client.Post(path, data, resource)
+	return resource, err
+}
+
+// Open marks a scheduled fulfillment order as ready for fulfillment
+func (s *FulfillmentOrderServiceOp) Open(fulfillmentOrder FulfillmentOrder) (*FulfillmentOrder, error) {
+	if err := checkFulfillmentOrderAction(fulfillmentOrder, FulfillmentOrderActionMarkAsOpen); err != nil {
+		return nil, err
+	}
+	return s.postFulfillmentOrder(fulfillmentOrder.ID, "open", nil)
+}
+
+// Reschedule the fulfill_at time of a scheduled fulfillment order
+func (s *FulfillmentOrderServiceOp) Reschedule(fulfillmentOrder FulfillmentOrder, newFulfillAt time.Time) (*FulfillmentOrder, error) {
+	if err := checkFulfillmentOrderStatus(fulfillmentOrder, "reschedule", false, "scheduled"); err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{
+		"fulfillment_order": map[string]time.Time{"new_fulfill_at": newFulfillAt},
+	}
+	return s.postFulfillmentOrder(fulfillmentOrder.ID, "reschedule", data)
+}
+
+// SetFulfillmentOrdersDeadline sets the latest date and time by which the
+// given fulfillment orders need to be fulfilled
+func (s *FulfillmentOrderServiceOp) SetFulfillmentOrdersDeadline(fulfillmentOrderIDs []int64, deadline time.Time) error {
+	path := "fulfillment_orders/set_fulfillment_orders_deadline.json"
+	data := map[string]interface{}{
+		"fulfillment_order_ids": fulfillmentOrderIDs,
+		"fulfillment_deadline":  deadline,
+	}
+	return s.client.Post(path, data, nil)
+}
+
+// SendFulfillmentRequest sends a fulfillment request to the fulfillment
+// service of a fulfillment order
+func (s *FulfillmentOrderServiceOp) SendFulfillmentRequest(fulfillmentOrder FulfillmentOrder, request FulfillmentRequest) (*FulfillmentRequestResult, error) {
+	if err := checkFulfillmentOrderAction(fulfillmentOrder, FulfillmentOrderActionRequestFulfillment); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf(fulfillmentOrdersBasePath+"/fulfillment_request.json", fulfillmentOrder.ID)
+	data := map[string]interface{}{"fulfillment_request": request}
+	resource := new(FulfillmentRequestResult)
+	err := s.client.Post(path, data, resource)
+	return resource, err
+}
+
+// AcceptFulfillmentRequest accepts a fulfillment request sent to a
+// fulfillment service
+func (s *FulfillmentOrderServiceOp) AcceptFulfillmentRequest(fulfillmentOrder FulfillmentOrder, message string) (*FulfillmentOrder, error) {
+	if err := checkFulfillmentOrderStatus(fulfillmentOrder, "accept fulfillment request", true, "submitted"); err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{
+		"fulfillment_request": map[string]string{"message": message},
+	}
+	return s.postFulfillmentOrder(fulfillmentOrder.ID, "fulfillment_request/accept", data)
+}
+
+// RejectFulfillmentRequest rejects a fulfillment request sent to a
+// fulfillment service
+func (s *FulfillmentOrderServiceOp) RejectFulfillmentRequest(fulfillmentOrder FulfillmentOrder, rejection FulfillmentRequestRejection) (*FulfillmentOrder, error) {
+	if err := checkFulfillmentOrderStatus(fulfillmentOrder, "reject fulfillment request", true, "submitted"); err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{"fulfillment_request": rejection}
+	return s.postFulfillmentOrder(fulfillmentOrder.ID, "fulfillment_request/reject", data)
+}
+
+// SendCancellationRequest sends a cancellation request to the fulfillment
+// service of a fulfillment order
+func (s *FulfillmentOrderServiceOp) SendCancellationRequest(fulfillmentOrder FulfillmentOrder, message string) (*FulfillmentOrder, error) {
+	if err := checkFulfillmentOrderAction(fulfillmentOrder, FulfillmentOrderActionRequestCancellation); err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{
+		"cancellation_request": map[string]string{"message": message},
+	}
+	return s.postFulfillmentOrder(fulfillmentOrder.ID, "cancellation_request", data)
+}
+
+// AcceptCancellationRequest accepts a cancellation request sent to a
+// fulfillment service
+func (s *FulfillmentOrderServiceOp) AcceptCancellationRequest(fulfillmentOrder FulfillmentOrder, message string) (*FulfillmentOrder, error) {
+	if err := checkFulfillmentOrderStatus(fulfillmentOrder, "accept cancellation request", true, "cancellation_requested"); err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{
+		"cancellation_request": map[string]string{"message": message},
+	}
+	return s.postFulfillmentOrder(fulfillmentOrder.ID, "cancellation_request/accept", data)
+}
+
+// RejectCancellationRequest rejects a cancellation request sent to a
+// fulfillment service
+func (s *FulfillmentOrderServiceOp) RejectCancellationRequest(fulfillmentOrder FulfillmentOrder, message string) (*FulfillmentOrder, error) {
+	if err := checkFulfillmentOrderStatus(fulfillmentOrder, "reject cancellation request", true, "cancellation_requested"); err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{
+		"cancellation_request": map[string]string{"message": message},
+	}
+	return s.postFulfillmentOrder(fulfillmentOrder.ID, "cancellation_request/reject", data)
+}
+
 func (s *FulfillmentOrderServiceOp) GetOrderList() []string {
 	str := new(FulfillmentOrder)
 
@@ -0,0 +1,169 @@
+package goshopify
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func fulfillmentOrderTests(t *testing.T, fulfillmentOrder *FulfillmentOrder) {
+	if fulfillmentOrder == nil {
+		t.Fatalf("FulfillmentOrder is nil")
+	}
+
+	expectedInt := int64(1046000818)
+	if fulfillmentOrder.ID != expectedInt {
+		t.Errorf("FulfillmentOrder.ID returned %+v, expected %+v", fulfillmentOrder.ID, expectedInt)
+	}
+
+	expectedStr := "open"
+	if fulfillmentOrder.Status != expectedStr {
+		t.Errorf("FulfillmentOrder.Status returned %+v, expected %+v", fulfillmentOrder.Status, expectedStr)
+	}
+
+	d := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if fulfillmentOrder.FulfillAt == nil || !d.Equal(*fulfillmentOrder.FulfillAt) {
+		t.Errorf("FulfillmentOrder.FulfillAt returned %+v, expected %+v", fulfillmentOrder.FulfillAt, d)
+	}
+}
+
+func TestFulfillmentOrderGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/1046000818.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_order.json")))
+
+	fulfillmentOrder, err := client.FulfillmentOrder.Get(1046000818, nil)
+	if err != nil {
+		t.Errorf("FulfillmentOrder.Get returned error: %v", err)
+	}
+
+	fulfillmentOrderTests(t, fulfillmentOrder)
+}
+
+func TestFulfillmentOrderHold(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/1046000818/hold.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_order.json")))
+
+	fulfillmentOrder := FulfillmentOrder{ID: 1046000818, SupportedActions: []string{FulfillmentOrderActionHold}}
+	returned, err := client.FulfillmentOrder.Hold(fulfillmentOrder, FulfillmentHold{Reason: "inventory_out_of_stock"})
+	if err != nil {
+		t.Errorf("FulfillmentOrder.Hold returned error: %v", err)
+	}
+
+	fulfillmentOrderTests(t, returned)
+}
+
+func registerLocationsForMove() {
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/1046000818/locations_for_move.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"locations_for_move":[{"location":{"id":655441491,"name":"Alpha"},"movable":true,"message":"Current location."},{"location":{"id":655441492,"name":"Beta"},"movable":false,"message":"No items are stocked at this location."}]}`))
+}
+
+func TestFulfillmentOrderMove(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerLocationsForMove()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/1046000818/move.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"original_fulfillment_order":{"id":1046000818,"status":"closed"},"moved_fulfillment_order":{"id":1046000819,"assigned_location_id":655441491,"status":"open"},"remaining_fulfillment_order":null}`))
+
+	fulfillmentOrder := FulfillmentOrder{ID: 1046000818, SupportedActions: []string{FulfillmentOrderActionMove}}
+	move, err := client.FulfillmentOrder.Move(fulfillmentOrder, 655441491)
+	if err != nil {
+		t.Fatalf("FulfillmentOrder.Move returned error: %v", err)
+	}
+
+	if move.MovedFulfillmentOrder == nil || move.MovedFulfillmentOrder.AssignedLocationID != 655441491 {
+		t.Errorf("FulfillmentOrder.Move returned %+v, expected moved fulfillment order at 655441491", move.MovedFulfillmentOrder)
+	}
+	if move.RemainingFulfillmentOrder != nil {
+		t.Errorf("FulfillmentOrder.Move returned remaining %+v, expected nil", move.RemainingFulfillmentOrder)
+	}
+}
+
+func TestFulfillmentOrderMoveInvalidLocation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerLocationsForMove()
+
+	fulfillmentOrder := FulfillmentOrder{ID: 1046000818, SupportedActions: []string{FulfillmentOrderActionMove}}
+	for _, locationID := range []int64{655441492, 1} {
+		_, err := client.FulfillmentOrder.Move(fulfillmentOrder, locationID)
+		if _, ok := err.(FulfillmentOrderActionError); !ok {
+			t.Errorf("FulfillmentOrder.Move to %d returned %v, expected FulfillmentOrderActionError", locationID, err)
+		}
+	}
+
+	info := httpmock.GetCallCountInfo()
+	if calls := info[fmt.Sprintf("POST https://fooshop.myshopify.com/%s/fulfillment_orders/1046000818/move.json", client.pathPrefix)]; calls != 0 {
+		t.Errorf("FulfillmentOrder.Move sent %d moves, expected none", calls)
+	}
+}
+
+func TestFulfillmentOrderUnsupportedAction(t *testing.T) {
+	setup()
+	defer teardown()
+
+	fulfillmentOrder := FulfillmentOrder{ID: 1046000818, Status: "open", SupportedActions: []string{FulfillmentOrderActionCreateFulfillment}}
+
+	_, err := client.FulfillmentOrder.Cancel(fulfillmentOrder)
+	if _, ok := err.(FulfillmentOrderActionError); !ok {
+		t.Errorf("FulfillmentOrder.Cancel returned %v, expected FulfillmentOrderActionError", err)
+	}
+
+	_, err = client.FulfillmentOrder.Reschedule(fulfillmentOrder, time.Now())
+	if _, ok := err.(FulfillmentOrderActionError); !ok {
+		t.Errorf("FulfillmentOrder.Reschedule returned %v, expected FulfillmentOrderActionError", err)
+	}
+
+	_, err = client.FulfillmentOrder.AcceptCancellationRequest(fulfillmentOrder, "")
+	if _, ok := err.(FulfillmentOrderActionError); !ok {
+		t.Errorf("FulfillmentOrder.AcceptCancellationRequest returned %v, expected FulfillmentOrderActionError", err)
+	}
+
+	if info := httpmock.GetTotalCallCount(); info != 0 {
+		t.Errorf("FulfillmentOrder sent %d requests for unsupported actions, expected 0", info)
+	}
+}
+
+func TestFulfillmentOrderSendFulfillmentRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/1046000818/fulfillment_request.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"original_fulfillment_order":{"id":1046000818},"submitted_fulfillment_order":{"id":1046000818,"request_status":"submitted"},"unsubmitted_fulfillment_order":null}`))
+
+	fulfillmentOrder := FulfillmentOrder{ID: 1046000818, SupportedActions: []string{FulfillmentOrderActionRequestFulfillment}}
+	result, err := client.FulfillmentOrder.SendFulfillmentRequest(fulfillmentOrder, FulfillmentRequest{
+		Message:                   "Fulfill this ASAP please.",
+		FulfillmentOrderLineItems: []FulfillmentOrderLineItemQuantity{{ID: 1025578640, Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("FulfillmentOrder.SendFulfillmentRequest returned error: %v", err)
+	}
+
+	if result.SubmittedFulfillmentOrder == nil || result.SubmittedFulfillmentOrder.RequestStatus != "submitted" {
+		t.Errorf("FulfillmentOrder.SendFulfillmentRequest returned %+v, expected submitted", result.SubmittedFulfillmentOrder)
+	}
+}
+
+func TestFulfillmentOrderSetFulfillmentOrdersDeadline(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/set_fulfillment_orders_deadline.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{}`))
+
+	err := client.FulfillmentOrder.SetFulfillmentOrdersDeadline([]int64{1046000818}, time.Now())
+	if err != nil {
+		t.Errorf("FulfillmentOrder.SetFulfillmentOrdersDeadline returned error: %v", err)
+	}
+}
@@ -7,8 +7,15 @@ import (
 
 const fulfillmentServicesBasePath = "fulfillment_services"
 
+// FulfillmentSvcService is an interface for interfacing with the fulfillment
+// service endpoints of the Shopify API.
+// See: https://shopify.dev/api/admin-rest/latest/resources/fulfillmentservice
 type FulfillmentSvcService interface {
 	List(interface{}) ([]FulfillmentSvc, error)
+	Get(int64, interface{}) (*FulfillmentSvc, error)
+	Create(FulfillmentSvc) (*FulfillmentSvc, error)
+	Update(FulfillmentSvc) (*FulfillmentSvc, error)
+	Delete(int64) error
 	GetOrderList() []string
 }
 
@@ -32,11 +39,15 @@ type FulfillmentSvc struct {
 	AdminGraphqlAPIID      string `json:"admin_graphql_api_id"`
 }
 
+type FulfillmentSvcResource struct {
+	FulfillmentSvc *FulfillmentSvc `json:"fulfillment_service"`
+}
+
 type FulfillmentSvcsResource struct {
 	FulfillmentSvcs []FulfillmentSvc `json:"fulfillment_services"`
 }
 
-// List shipping zones
+// List fulfillment services
 func (s *FulfillmentSvcServiceOp) List(options interface{}) ([]FulfillmentSvc, error) {
 	path := fmt.Sprintf("%s.json", fulfillmentServicesBasePath)
 	resource := new(FulfillmentSvcsResource)
@@ -44,6 +55,37 @@ func (s *FulfillmentSvcServiceOp) List(options interface{}) ([]FulfillmentSvc, e
 	return resource.FulfillmentSvcs, err
 }
 
+// Get individual fulfillment service
+func (s *FulfillmentSvcServiceOp) Get(fulfillmentServiceID int64, options interface{}) (*FulfillmentSvc, error) {
+	path := fmt.Sprintf("%s/%d.json", fulfillmentServicesBasePath, fulfillmentServiceID)
+	resource := new(FulfillmentSvcResource)
+	err := s.client.Get(path, resource, options)
+	return resource.FulfillmentSvc, err
+}
+
+// Create a new fulfillment service
+func (s *FulfillmentSvcServiceOp) Create(fulfillmentService FulfillmentSvc) (*FulfillmentSvc, error) {
+	path := fmt.Sprintf("%s.json", fulfillmentServicesBasePath)
+	wrappedData := FulfillmentSvcResource{FulfillmentSvc: &fulfillmentService}
+	resource := new(FulfillmentSvcResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.FulfillmentSvc, err
+}
+
+// Update an existing fulfillment service
+func (s *FulfillmentSvcServiceOp) Update(fulfillmentService FulfillmentSvc) (*FulfillmentSvc, error) {
+	path := fmt.Sprintf("%s/%d.json", fulfillmentServicesBasePath, fulfillmentService.ID)
+	wrappedData := FulfillmentSvcResource{FulfillmentSvc: &fulfillmentService}
+	resource := new(FulfillmentSvcResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.FulfillmentSvc, err
+}
+
+// Delete an existing fulfillment service
+func (s *FulfillmentSvcServiceOp) Delete(fulfillmentServiceID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d.json", fulfillmentServicesBasePath, fulfillmentServiceID))
+}
+
 func (s *FulfillmentSvcServiceOp) GetOrderList() []string {
 	str := new(FulfillmentSvc)
 
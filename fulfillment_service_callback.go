@@ -0,0 +1,202 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// Kinds of fulfillment order notifications sent to a fulfillment service.
+const (
+	FulfillmentOrderNotificationFulfillmentRequest  = "FULFILLMENT_REQUEST"
+	FulfillmentOrderNotificationCancellationRequest = "CANCELLATION_REQUEST"
+)
+
+// FulfillmentServiceStockProvider answers the fetch_stock callback of a
+// fulfillment service. When sku is empty the stock of every SKU must be
+// returned.
+type FulfillmentServiceStockProvider interface {
+	FetchStock(shop, sku string) (map[string]int, error)
+}
+
+// FulfillmentServiceTrackingProvider answers the fetch_tracking_numbers
+// callback of a fulfillment service with a tracking number per order name,
+// e.g. {"#1001.1": "qwerty"}.
+type FulfillmentServiceTrackingProvider interface {
+	FetchTrackingNumbers(shop string, orderNames []string) (map[string]string, error)
+}
+
+// FulfillmentOrderNotificationReceiver is notified when a fulfillment or
+// cancellation request is sent to the fulfillment service. The assigned
+// fulfillment orders can then be listed with AssignedFulfillmentOrderService.
+type FulfillmentOrderNotificationReceiver interface {
+	FulfillmentOrderNotification(shop, kind string) error
+}
+
+// FulfillmentOrderNotification is the body of a fulfillment_order_notification
+// callback.
+type FulfillmentOrderNotification struct {
+	Kind string `json:"kind"`
+}
+
+// FulfillmentTrackingNumbers is the response to a fetch_tracking_numbers
+// callback.
+type FulfillmentTrackingNumbers struct {
+	TrackingNumbers map[string]string `json:"tracking_numbers"`
+	Message         string            `json:"message"`
+	Success         bool              `json:"success"`
+}
+
+// FulfillmentServiceHandler is an http.Handler for the endpoints Shopify calls
+// under the callback_url of a fulfillment service:
+//   - GET  <callback_url>/fetch_stock.json
+//   - GET  <callback_url>/fetch_tracking_numbers.json
+//   - POST <callback_url>/fulfillment_order_notification
+//
+// Requests are verified with the App's ApiSecret and dispatched to the
+// providers. The body of POST callbacks is verified against the
+// X-Shopify-Hmac-Sha256 header. GET callbacks have no body, so their query
+// parameters must be signed with an hmac parameter, the way Shopify signs
+// OAuth and app proxy requests. A callback whose provider is nil responds
+// with a 404.
+type FulfillmentServiceHandler struct {
+	app App
+
+	Stock         FulfillmentServiceStockProvider
+	Tracking      FulfillmentServiceTrackingProvider
+	Notifications FulfillmentOrderNotificationReceiver
+
+	// UnsignedQueries serves GET callbacks without an hmac query parameter.
+	// Their shop, sku and order_names are then not authenticated, and must
+	// be protected some other way, e.g. by a secret in the callback_url.
+	UnsignedQueries bool
+}
+
+// NewFulfillmentServiceHandler returns a FulfillmentServiceHandler verifying
+// requests with the App's ApiSecret.
+func (app App) NewFulfillmentServiceHandler() *FulfillmentServiceHandler {
+	return &FulfillmentServiceHandler{app: app}
+}
+
+// ServeHTTP verifies and dispatches a fulfillment service callback.
+func (h *FulfillmentServiceHandler) ServeHTTP(w http.ResponseWriter, httpRequest *http.Request) {
+	if !h.verify(httpRequest) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	shop := httpRequest.URL.Query().Get("shop")
+	if shop == "" {
+		shop = httpRequest.Header.Get(webhookShopDomainHeader)
+	}
+
+	callback := httpRequest.URL.Path[strings.LastIndex(httpRequest.URL.Path, "/")+1:]
+	switch strings.TrimSuffix(callback, ".json") {
+	case "fetch_stock":
+		if h.Stock == nil {
+			break
+		}
+		if !allowMethod(w, httpRequest, http.MethodGet) {
+			return
+		}
+		stock, err := h.Stock.FetchStock(shop, httpRequest.URL.Query().Get("sku"))
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, stock)
+		return
+
+	case "fetch_tracking_numbers":
+		if h.Tracking == nil {
+			break
+		}
+		if !allowMethod(w, httpRequest, http.MethodGet) {
+			return
+		}
+		query := httpRequest.URL.Query()
+		orderNames := query["order_names[]"]
+		if len(orderNames) == 0 {
+			orderNames = query["order_names"]
+		}
+		trackingNumbers, err := h.Tracking.FetchTrackingNumbers(shop, orderNames)
+		if err != nil {
+			writeJSON(w, FulfillmentTrackingNumbers{Message: err.Error()})
+			return
+		}
+		writeJSON(w, FulfillmentTrackingNumbers{
+			TrackingNumbers: trackingNumbers,
+			Message:         "Successfully received the tracking numbers",
+			Success:         true,
+		})
+		return
+
+	case "fulfillment_order_notification":
+		if h.Notifications == nil {
+			break
+		}
+		if !allowMethod(w, httpRequest, http.MethodPost) {
+			return
+		}
+		body, err := ioutil.ReadAll(httpRequest.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		notification := new(FulfillmentOrderNotification)
+		if err := json.Unmarshal(body, notification); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := h.Notifications.FulfillmentOrderNotification(shop, notification.Kind); err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	http.NotFound(w, httpRequest)
+}
+
+// verify checks the signed query of GET callbacks and the signed body of the
+// other callbacks.
+func (h *FulfillmentServiceHandler) verify(httpRequest *http.Request) bool {
+	if httpRequest.Method != http.MethodGet {
+		ok, err := h.app.VerifyWebhookRequestVerbose(httpRequest)
+		return ok && err == nil
+	}
+
+	if httpRequest.URL.Query().Get("hmac") == "" {
+		return h.UnsignedQueries
+	}
+	if h.app.ApiSecret == "" {
+		return false
+	}
+	ok, err := h.app.VerifyAuthorizationURL(httpRequest.URL)
+	return ok && err == nil
+}
+
+// allowMethod responds with a 405 and returns false if the request does not
+// use method.
+func allowMethod(w http.ResponseWriter, httpRequest *http.Request, method string) bool {
+	if httpRequest.Method == method {
+		return true
+	}
+	w.Header().Set("Allow", method)
+	http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	return false
+}
+
+// writeJSON responds with v encoded as JSON.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
@@ -0,0 +1,260 @@
+package goshopify
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func fulfillmentSvcTests(t *testing.T, fulfillmentService *FulfillmentSvc) {
+	if fulfillmentService == nil {
+		t.Fatalf("FulfillmentSvc is nil")
+	}
+
+	expectedInt := int64(1061774487)
+	if fulfillmentService.ID != expectedInt {
+		t.Errorf("FulfillmentSvc.ID returned %+v, expected %+v", fulfillmentService.ID, expectedInt)
+	}
+
+	expectedStr := "http://google.com/"
+	if fulfillmentService.CallbackURL != expectedStr {
+		t.Errorf("FulfillmentSvc.CallbackURL returned %+v, expected %+v", fulfillmentService.CallbackURL, expectedStr)
+	}
+}
+
+func TestFulfillmentSvcGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_services/1061774487.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_service.json")))
+
+	fulfillmentService, err := client.FulfillmentSvc.Get(1061774487, nil)
+	if err != nil {
+		t.Errorf("FulfillmentSvc.Get returned error: %v", err)
+	}
+
+	fulfillmentSvcTests(t, fulfillmentService)
+}
+
+func TestFulfillmentSvcCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_services.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("fulfillment_service.json")))
+
+	fulfillmentService, err := client.FulfillmentSvc.Create(FulfillmentSvc{
+		Name:                   "Jupiter Fulfillment",
+		CallbackURL:            "http://google.com/",
+		InventoryManagement:    true,
+		TrackingSupport:        true,
+		FulfillmentOrdersOptIn: true,
+	})
+	if err != nil {
+		t.Errorf("FulfillmentSvc.Create returned error: %v", err)
+	}
+
+	fulfillmentSvcTests(t, fulfillmentService)
+}
+
+func TestFulfillmentSvcUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_services/1061774487.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_service.json")))
+
+	fulfillmentService, err := client.FulfillmentSvc.Update(FulfillmentSvc{ID: 1061774487, Name: "Jupiter Fulfillment"})
+	if err != nil {
+		t.Errorf("FulfillmentSvc.Update returned error: %v", err)
+	}
+
+	fulfillmentSvcTests(t, fulfillmentService)
+}
+
+func TestFulfillmentSvcDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_services/1061774487.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.FulfillmentSvc.Delete(1061774487)
+	if err != nil {
+		t.Errorf("FulfillmentSvc.Delete returned error: %v", err)
+	}
+}
+
+type testFulfillmentServiceProvider struct {
+	kinds []string
+}
+
+func (p *testFulfillmentServiceProvider) FetchStock(shop, sku string) (map[string]int, error) {
+	if sku == "broken" {
+		return nil, errors.New("boom")
+	}
+	return map[string]int{sku: 23}, nil
+}
+
+func (p *testFulfillmentServiceProvider) FetchTrackingNumbers(shop string, orderNames []string) (map[string]string, error) {
+	trackingNumbers := map[string]string{}
+	for _, name := range orderNames {
+		trackingNumbers[name] = "track-" + name
+	}
+	return trackingNumbers, nil
+}
+
+func (p *testFulfillmentServiceProvider) FulfillmentOrderNotification(shop, kind string) error {
+	p.kinds = append(p.kinds, kind)
+	return nil
+}
+
+func signedCallbackRequest(secret, method, target, body string) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewBufferString(body))
+	req.Header.Set("X-Shopify-Hmac-Sha256", App{ApiSecret: secret}.SignWebhookPayload([]byte(body)))
+	return req
+}
+
+// signedCallbackQuery adds an hmac parameter signing the query of target.
+func signedCallbackQuery(secret, target string) string {
+	u, _ := url.Parse(target)
+	q := u.Query()
+	message, _ := url.QueryUnescape(q.Encode())
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(message))
+	q.Set("hmac", hex.EncodeToString(mac.Sum(nil)))
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func TestFulfillmentServiceHandler(t *testing.T) {
+	setup()
+	defer teardown()
+
+	provider := new(testFulfillmentServiceProvider)
+	handler := app.NewFulfillmentServiceHandler()
+	handler.Stock = provider
+	handler.Tracking = provider
+	handler.Notifications = provider
+
+	cases := []struct {
+		name         string
+		req          *http.Request
+		expected     int
+		expectedBody string
+	}{
+		{
+			"fetch stock",
+			httptest.NewRequest("GET", signedCallbackQuery(app.ApiSecret, "/fulfillment/fetch_stock.json?sku=123&shop=fooshop.myshopify.com"), nil),
+			http.StatusOK,
+			`{"123":23}`,
+		},
+		{
+			"fetch tracking numbers",
+			httptest.NewRequest("GET", signedCallbackQuery(app.ApiSecret, "/fulfillment/fetch_tracking_numbers.json?order_names[]=%231001.1&shop=fooshop.myshopify.com"), nil),
+			http.StatusOK,
+			`{"tracking_numbers":{"#1001.1":"track-#1001.1"},"message":"Successfully received the tracking numbers","success":true}`,
+		},
+		{
+			"notification",
+			signedCallbackRequest(app.ApiSecret, "POST", "/fulfillment/fulfillment_order_notification", `{"kind":"FULFILLMENT_REQUEST"}`),
+			http.StatusOK,
+			"",
+		},
+		{
+			"stock error",
+			httptest.NewRequest("GET", signedCallbackQuery(app.ApiSecret, "/fulfillment/fetch_stock.json?sku=broken"), nil),
+			http.StatusInternalServerError,
+			"Internal Server Error\n",
+		},
+		{
+			"bad signature",
+			httptest.NewRequest("GET", signedCallbackQuery("wrong", "/fulfillment/fetch_stock.json?sku=123"), nil),
+			http.StatusUnauthorized,
+			"Unauthorized\n",
+		},
+		{
+			"replayed signature",
+			func() *http.Request {
+				signed, _ := url.Parse(signedCallbackQuery(app.ApiSecret, "/fulfillment/fetch_stock.json?sku=123"))
+				q := signed.Query()
+				q.Set("sku", "456")
+				signed.RawQuery = q.Encode()
+				return httptest.NewRequest("GET", signed.String(), nil)
+			}(),
+			http.StatusUnauthorized,
+			"Unauthorized\n",
+		},
+		{
+			"unsigned query",
+			httptest.NewRequest("GET", "/fulfillment/fetch_stock.json?sku=123", nil),
+			http.StatusUnauthorized,
+			"Unauthorized\n",
+		},
+		{
+			"body signature on a query",
+			signedCallbackRequest(app.ApiSecret, "GET", "/fulfillment/fetch_stock.json?sku=123", ""),
+			http.StatusUnauthorized,
+			"Unauthorized\n",
+		},
+		{
+			"wrong method",
+			signedCallbackRequest(app.ApiSecret, "POST", "/fulfillment/fetch_stock.json", "{}"),
+			http.StatusMethodNotAllowed,
+			"Method Not Allowed\n",
+		},
+		{
+			"unknown callback",
+			httptest.NewRequest("GET", signedCallbackQuery(app.ApiSecret, "/fulfillment/fetch_rates.json?shop=fooshop.myshopify.com"), nil),
+			http.StatusNotFound,
+			"404 page not found\n",
+		},
+	}
+
+	for _, c := range cases {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, c.req)
+		if rec.Code != c.expected {
+			t.Errorf("FulfillmentServiceHandler %s returned status %d, expected %d", c.name, rec.Code, c.expected)
+		}
+		if rec.Body.String() != c.expectedBody {
+			t.Errorf("FulfillmentServiceHandler %s returned %q, expected %q", c.name, rec.Body.String(), c.expectedBody)
+		}
+	}
+
+	if !reflect.DeepEqual(provider.kinds, []string{FulfillmentOrderNotificationFulfillmentRequest}) {
+		t.Errorf("FulfillmentServiceHandler notified %v, expected [%s]", provider.kinds, FulfillmentOrderNotificationFulfillmentRequest)
+	}
+}
+
+func TestFulfillmentServiceHandlerUnsignedQueries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := app.NewFulfillmentServiceHandler()
+	handler.Stock = new(testFulfillmentServiceProvider)
+	handler.UnsignedQueries = true
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/fulfillment/fetch_stock.json?sku=123", nil))
+	if rec.Code != http.StatusOK || rec.Body.String() != `{"123":23}` {
+		t.Errorf("FulfillmentServiceHandler returned %d %q, expected the stock of 123", rec.Code, rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", signedCallbackQuery("wrong", "/fulfillment/fetch_stock.json?sku=123"), nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("FulfillmentServiceHandler returned %d for a bad hmac, expected %d", rec.Code, http.StatusUnauthorized)
+	}
+}
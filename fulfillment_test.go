@@ -2,6 +2,8 @@ package goshopify
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
@@ -194,3 +196,52 @@ func TestFulfillmentCancel(t *testing.T) {
 
 	FulfillmentTests(t, *returnedFulfillment)
 }
+
+func TestFulfillmentCreateForFulfillmentOrders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillments.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			expected := `{"fulfillment":{"message":"The package was shipped this morning.","notify_customer":true,` +
+				`"tracking_info":{"number":"123456789","company":"UPS"},` +
+				`"line_items_by_fulfillment_order":[{"fulfillment_order_id":1046000818,"fulfillment_order_line_items":[{"id":1025578640,"quantity":1}]}]}}`
+			if string(body) != expected {
+				t.Errorf("Fulfillment.CreateForFulfillmentOrders sent %s, expected %s", body, expected)
+			}
+			return httpmock.NewBytesResponse(201, loadFixture("fulfillment.json")), nil
+		})
+
+	fulfillment, err := client.Fulfillment.CreateForFulfillmentOrders(FulfillmentForFulfillmentOrders{
+		Message:        "The package was shipped this morning.",
+		NotifyCustomer: true,
+		TrackingInfo:   &FulfillmentTrackingInfo{Number: "123456789", Company: "UPS"},
+		LineItemsByFulfillmentOrder: []FulfillmentOrderLineItems{
+			{
+				FulfillmentOrderID:        1046000818,
+				FulfillmentOrderLineItems: []FulfillmentOrderLineItemQuantity{{ID: 1025578640, Quantity: 1}},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("Fulfillment.CreateForFulfillmentOrders returned error: %v", err)
+	}
+
+	FulfillmentTests(t, *fulfillment)
+}
+
+func TestFulfillmentUpdateTracking(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillments/1022782888/update_tracking.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment.json")))
+
+	fulfillment, err := client.Fulfillment.UpdateTracking(1022782888, FulfillmentTrackingInfo{Number: "1111", Company: "UPS"}, true)
+	if err != nil {
+		t.Errorf("Fulfillment.UpdateTracking returned error: %v", err)
+	}
+
+	FulfillmentTests(t, *fulfillment)
+}
@@ -1,9 +1,14 @@
 package goshopify
 
 import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -11,6 +16,17 @@ import (
 
 const giftCardBasePath = "gift_cards"
 
+// Gift card codes must be between 8 and 20 characters long and contain only
+// letters and digits. Shopify generates 16 character codes.
+const (
+	GiftCardCodeMinLength     = 8
+	GiftCardCodeMaxLength     = 20
+	GiftCardCodeDefaultLength = 16
+
+	giftCardCodeAlphabet    = "abcdefghijklmnopqrstuvwxyz0123456789"
+	giftCardExpiresOnFormat = "2006-01-02"
+)
+
 // GiftCardService is an interface for interacting with the
 // GiftCard endpoints of the Shopify API.
 // See https://help.shopify.com/api/reference/giftCards
@@ -18,6 +34,11 @@ type GiftCardService interface {
 	Get(int64, interface{}) (*GiftCard, error)
 	List(interface{}) ([]GiftCard, error)
 	ListWithPagination(interface{}) ([]GiftCard, *Pagination, error)
+	Count(interface{}) (int, error)
+	Search(interface{}) ([]GiftCard, error)
+	Create(GiftCard) (*GiftCard, error)
+	Update(GiftCard) (*GiftCard, error)
+	Disable(int64) (*GiftCard, error)
 	GetOrderList() []string
 }
 
@@ -30,16 +51,17 @@ type GiftCard struct {
 	Balance        *decimal.Decimal `json:"balance"`
 	CreatedAt      *time.Time       `json:"created_at"`
 	UpdatedAt      *time.Time       `json:"updated_at"`
-	Currency       string           `json:"currency"`
-	InitialValue   *decimal.Decimal `json:"initial_value"`
+	Currency       string           `json:"currency,omitempty"`
+	InitialValue   *decimal.Decimal `json:"initial_value,omitempty"`
 	DisabledAt     *time.Time       `json:"disabled_at"`
 	LineItemID     int64            `json:"line_item_id"`
 	APIClientID    int64            `json:"api_client_id"`
 	UserID         int64            `json:"user_id"`
-	CustomerID     interface{}      `json:"customer_id"`
+	CustomerID     int64            `json:"customer_id,omitempty"`
 	Note           string           `json:"note"`
 	ExpiresOn      *time.Time       `json:"expires_on"`
 	TemplateSuffix string           `json:"template_suffix"`
+	Code           string           `json:"code,omitempty"`
 	LastCharacters string           `json:"last_characters"`
 	OrderID        int64            `json:"order_id"`
 }
@@ -56,18 +78,101 @@ type GiftCardsResource struct {
 	GiftCards []GiftCard `json:"gift_cards"`
 }
 
+// GiftCardSearchOptions represents the options available when searching for
+// gift cards. Query supports the created_at, updated_at, disabled_at, balance,
+// initial_value, amount_spent, email and last_characters fields, e.g.
+// "last_characters:mnop".
+type GiftCardSearchOptions struct {
+	PageInfo string `url:"page_info,omitempty"`
+	Limit    int    `url:"limit,omitempty"`
+	Fields   string `url:"fields,omitempty"`
+	Order    string `url:"order,omitempty"`
+	Query    string `url:"query,omitempty"`
+}
+
+// ErrGiftCardCode is returned when a gift card code does not follow Shopify's
+// format rules.
+var ErrGiftCardCode = fmt.Errorf("gift card code must be %d to %d letters or digits", GiftCardCodeMinLength, GiftCardCodeMaxLength)
+
+// ValidateGiftCardCode checks that code follows Shopify's format rules.
+func ValidateGiftCardCode(code string) error {
+	if len(code) < GiftCardCodeMinLength || len(code) > GiftCardCodeMaxLength {
+		return ErrGiftCardCode
+	}
+	for _, c := range strings.ToLower(code) {
+		if !strings.ContainsRune(giftCardCodeAlphabet, c) {
+			return ErrGiftCardCode
+		}
+	}
+	return nil
+}
+
+// GenerateGiftCardCode returns a random lowercase alphanumeric gift card code
+// of the given length. A length of 0 uses GiftCardCodeDefaultLength.
+func GenerateGiftCardCode(length int) (string, error) {
+	if length == 0 {
+		length = GiftCardCodeDefaultLength
+	}
+	if length < GiftCardCodeMinLength || length > GiftCardCodeMaxLength {
+		return "", ErrGiftCardCode
+	}
+
+	max := big.NewInt(int64(len(giftCardCodeAlphabet)))
+	code := make([]byte, length)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = giftCardCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// UnmarshalJSON parses expires_on, which Shopify sends as a date without a
+// time.
+func (g *GiftCard) UnmarshalJSON(data []byte) error {
+	type alias GiftCard
+	aux := &struct {
+		ExpiresOn *string `json:"expires_on"`
+		*alias
+	}{alias: (*alias)(g)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	return parse(&g.ExpiresOn, aux.ExpiresOn)
+}
+
+// MarshalJSON sends expires_on as a date, the only format Shopify accepts.
+func (g GiftCard) MarshalJSON() ([]byte, error) {
+	type alias GiftCard
+	aux := struct {
+		ExpiresOn *string `json:"expires_on,omitempty"`
+		alias
+	}{alias: alias(g)}
+
+	if g.ExpiresOn != nil {
+		expiresOn := g.ExpiresOn.Format(giftCardExpiresOnFormat)
+		aux.ExpiresOn = &expiresOn
+	}
+	return json.Marshal(aux)
+}
+
 // Get gets individual application giftCard.
 func (s GiftCardServiceOp) Get(giftCardID int64, options interface{}) (*GiftCard, error) {
 	path := fmt.Sprintf("%s/%d.json", giftCardBasePath, giftCardID)
 	resource := &GiftCardResource{}
-	return resource.GiftCard, s.client.Get(path, resource, options)
+	err := s.client.Get(path, resource, options)
+	return resource.GiftCard, err
 }
 
 // List gets all application giftCards.
 func (s GiftCardServiceOp) List(options interface{}) ([]GiftCard, error) {
 	path := fmt.Sprintf("%s.json", giftCardBasePath)
 	resource := &GiftCardsResource{}
-	return resource.GiftCards, s.client.Get(path, resource, options)
+	err := s.client.Get(path, resource, options)
+	return resource.GiftCards, err
 }
 
 func (s *GiftCardServiceOp) ListWithPagination(options interface{}) ([]GiftCard, *Pagination, error) {
@@ -91,6 +196,77 @@ func (s *GiftCardServiceOp) ListWithPagination(options interface{}) ([]GiftCard,
 	return resource.GiftCards, pagination, nil
 }
 
+// Count gift cards, optionally filtered by status (enabled or disabled)
+func (s *GiftCardServiceOp) Count(options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", giftCardBasePath)
+	return s.client.Count(path, options)
+}
+
+// Search gift cards, see GiftCardSearchOptions
+func (s *GiftCardServiceOp) Search(options interface{}) ([]GiftCard, error) {
+	path := fmt.Sprintf("%s/search.json", giftCardBasePath)
+	resource := new(GiftCardsResource)
+	err := s.client.Get(path, resource, options)
+	return resource.GiftCards, err
+}
+
+// Create issues a new gift card. Shopify generates a code when Code is empty;
+// the full code is only returned by this call.
+func (s *GiftCardServiceOp) Create(giftCard GiftCard) (*GiftCard, error) {
+	if giftCard.Code != "" {
+		if err := ValidateGiftCardCode(giftCard.Code); err != nil {
+			return nil, err
+		}
+	}
+	if giftCard.InitialValue == nil {
+		return nil, errors.New("gift card initial value is required")
+	}
+	path := fmt.Sprintf("%s.json", giftCardBasePath)
+	wrappedData := GiftCardResource{GiftCard: &giftCard}
+	resource := new(GiftCardResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.GiftCard, err
+}
+
+// giftCardUpdate holds the fields of a gift card that can be updated.
+type giftCardUpdate struct {
+	ID             int64   `json:"id"`
+	ExpiresOn      *string `json:"expires_on,omitempty"`
+	Note           string  `json:"note,omitempty"`
+	TemplateSuffix string  `json:"template_suffix,omitempty"`
+	CustomerID     int64   `json:"customer_id,omitempty"`
+}
+
+// Update an existing gift card. Only the expiry date, note, template suffix
+// and customer of a gift card can be changed, so only these fields are sent
+// and empty ones are left unchanged.
+func (s *GiftCardServiceOp) Update(giftCard GiftCard) (*GiftCard, error) {
+	path := fmt.Sprintf("%s/%d.json", giftCardBasePath, giftCard.ID)
+	update := giftCardUpdate{
+		ID:             giftCard.ID,
+		Note:           giftCard.Note,
+		TemplateSuffix: giftCard.TemplateSuffix,
+		CustomerID:     giftCard.CustomerID,
+	}
+	if giftCard.ExpiresOn != nil {
+		expiresOn := giftCard.ExpiresOn.Format(giftCardExpiresOnFormat)
+		update.ExpiresOn = &expiresOn
+	}
+	wrappedData := map[string]interface{}{"gift_card": update}
+	resource := new(GiftCardResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.GiftCard, err
+}
+
+// Disable a gift card. Disabling cannot be undone.
+func (s *GiftCardServiceOp) Disable(giftCardID int64) (*GiftCard, error) {
+	path := fmt.Sprintf("%s/%d/disable.json", giftCardBasePath, giftCardID)
+	wrappedData := GiftCardResource{GiftCard: &GiftCard{ID: giftCardID}}
+	resource := new(GiftCardResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.GiftCard, err
+}
+
 func (s *GiftCardServiceOp) GetOrderList() []string {
 	str := new(GiftCard)
 
@@ -0,0 +1,250 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func giftCardTests(t *testing.T, giftCard GiftCard) {
+	if giftCard.ID != 1035197676 {
+		t.Errorf("GiftCard.ID returned %+v, expected %+v", giftCard.ID, 1035197676)
+	}
+
+	if giftCard.CustomerID != 207119551 {
+		t.Errorf("GiftCard.CustomerID returned %+v, expected %+v", giftCard.CustomerID, 207119551)
+	}
+
+	expectedValue := decimal.NewFromInt(100)
+	if giftCard.InitialValue == nil || !giftCard.InitialValue.Equal(expectedValue) {
+		t.Errorf("GiftCard.InitialValue returned %v, expected %v", giftCard.InitialValue, expectedValue)
+	}
+
+	expectedExpiresOn := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if giftCard.ExpiresOn == nil || !giftCard.ExpiresOn.Equal(expectedExpiresOn) {
+		t.Errorf("GiftCard.ExpiresOn returned %v, expected %v", giftCard.ExpiresOn, expectedExpiresOn)
+	}
+}
+
+func TestGiftCardGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/gift_cards/1035197676.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("gift_card.json")))
+
+	giftCard, err := client.GiftCard.Get(1035197676, nil)
+	if err != nil {
+		t.Fatalf("GiftCard.Get returned error: %v", err)
+	}
+
+	giftCardTests(t, *giftCard)
+}
+
+func TestGiftCardCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/gift_cards/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 3}`))
+
+	params := map[string]string{"status": "enabled"}
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/gift_cards/count.json", client.pathPrefix),
+		params, httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.GiftCard.Count(nil)
+	if err != nil {
+		t.Errorf("GiftCard.Count returned error: %v", err)
+	}
+
+	expected := 3
+	if cnt != expected {
+		t.Errorf("GiftCard.Count returned %d, expected %d", cnt, expected)
+	}
+
+	cnt, err = client.GiftCard.Count(struct {
+		Status string `url:"status"`
+	}{"enabled"})
+	if err != nil {
+		t.Errorf("GiftCard.Count returned error: %v", err)
+	}
+
+	expected = 2
+	if cnt != expected {
+		t.Errorf("GiftCard.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestGiftCardSearch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"query": "last_characters:0y0y"}
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/gift_cards/search.json", client.pathPrefix),
+		params, httpmock.NewStringResponder(200, `{"gift_cards":[{"id":1035197676,"last_characters":"0y0y"}]}`))
+
+	giftCards, err := client.GiftCard.Search(GiftCardSearchOptions{Query: "last_characters:0y0y"})
+	if err != nil {
+		t.Fatalf("GiftCard.Search returned error: %v", err)
+	}
+
+	if len(giftCards) != 1 || giftCards[0].ID != 1035197676 {
+		t.Errorf("GiftCard.Search returned %+v, expected gift card 1035197676", giftCards)
+	}
+}
+
+func TestGiftCardCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body map[string]map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/gift_cards.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			return httpmock.NewBytesResponse(201, loadFixture("gift_card.json")), nil
+		})
+
+	value := decimal.NewFromInt(100)
+	expiresOn := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	giftCard, err := client.GiftCard.Create(GiftCard{
+		Code:         "1234567890ab0y0y",
+		InitialValue: &value,
+		ExpiresOn:    &expiresOn,
+		CustomerID:   207119551,
+	})
+	if err != nil {
+		t.Fatalf("GiftCard.Create returned error: %v", err)
+	}
+
+	giftCardTests(t, *giftCard)
+	if giftCard.Code != "1234567890ab0y0y" {
+		t.Errorf("GiftCard.Code returned %s, expected 1234567890ab0y0y", giftCard.Code)
+	}
+
+	sent := body["gift_card"]
+	if sent["expires_on"] != "2025-01-01" || sent["code"] != "1234567890ab0y0y" || sent["initial_value"] != "100" {
+		t.Errorf("GiftCard.Create sent %+v", sent)
+	}
+}
+
+func TestGiftCardCreateInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	value := decimal.NewFromInt(100)
+	_, err := client.GiftCard.Create(GiftCard{Code: "short", InitialValue: &value})
+	if err != ErrGiftCardCode {
+		t.Errorf("GiftCard.Create returned error %v, expected %v", err, ErrGiftCardCode)
+	}
+
+	_, err = client.GiftCard.Create(GiftCard{})
+	if err == nil {
+		t.Errorf("GiftCard.Create expected an error without an initial value")
+	}
+}
+
+func TestGiftCardUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent map[string]map[string]interface{}
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/gift_cards/1035197676.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				return nil, err
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("gift_card.json")), nil
+		})
+
+	balance := decimal.NewFromInt(25)
+	expiresOn := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	giftCard, err := client.GiftCard.Update(GiftCard{
+		ID:           1035197676,
+		Note:         "Birthday gift",
+		ExpiresOn:    &expiresOn,
+		CustomerID:   207119551,
+		Balance:      &balance,
+		InitialValue: &balance,
+		Code:         "abcd1234efgh5678",
+	})
+	if err != nil {
+		t.Fatalf("GiftCard.Update returned error: %v", err)
+	}
+
+	giftCardTests(t, *giftCard)
+
+	expected := map[string]interface{}{
+		"id":          float64(1035197676),
+		"note":        "Birthday gift",
+		"expires_on":  "2025-01-01",
+		"customer_id": float64(207119551),
+	}
+	if !reflect.DeepEqual(sent["gift_card"], expected) {
+		t.Errorf("GiftCard.Update sent %v, expected %v", sent["gift_card"], expected)
+	}
+}
+
+func TestGiftCardDisable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/gift_cards/1035197676/disable.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"gift_card":{"id":1035197676,"disabled_at":"2021-12-02T19:51:11-05:00"}}`))
+
+	giftCard, err := client.GiftCard.Disable(1035197676)
+	if err != nil {
+		t.Fatalf("GiftCard.Disable returned error: %v", err)
+	}
+
+	if giftCard.DisabledAt == nil {
+		t.Errorf("GiftCard.DisabledAt returned nil, expected a time")
+	}
+}
+
+func TestGenerateGiftCardCode(t *testing.T) {
+	code, err := GenerateGiftCardCode(0)
+	if err != nil {
+		t.Fatalf("GenerateGiftCardCode returned error: %v", err)
+	}
+	if len(code) != GiftCardCodeDefaultLength {
+		t.Errorf("GenerateGiftCardCode returned %s, expected %d characters", code, GiftCardCodeDefaultLength)
+	}
+	if err := ValidateGiftCardCode(code); err != nil {
+		t.Errorf("GenerateGiftCardCode returned invalid code %s: %v", code, err)
+	}
+
+	for _, length := range []int{7, 21} {
+		if _, err := GenerateGiftCardCode(length); err != ErrGiftCardCode {
+			t.Errorf("GenerateGiftCardCode(%d) returned error %v, expected %v", length, err, ErrGiftCardCode)
+		}
+	}
+}
+
+func TestValidateGiftCardCode(t *testing.T) {
+	cases := []struct {
+		code  string
+		valid bool
+	}{
+		{"abcd1234", true},
+		{"ABCD1234EFGH5678", true},
+		{"abcd123", false},
+		{"abcd1234abcd1234abcd1", false},
+		{"abcd-1234", false},
+		{"abcd 1234", false},
+	}
+
+	for _, c := range cases {
+		err := ValidateGiftCardCode(c.code)
+		if (err == nil) != c.valid {
+			t.Errorf("ValidateGiftCardCode(%q) returned %v, expected valid %v", c.code, err, c.valid)
+		}
+	}
+}
@@ -439,6 +439,16 @@ func (c *Client) Do(req *http.Request, v interface{}) error {
 
 // doGetHeaders executes a request, decoding the response into `v` and also returns any response headers.
 func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, error) {
+	resp, err := c.doGetResponse(req, v)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Header, nil
+}
+
+// doGetResponse does the request like doGetHeaders and returns the response,
+// whose body is already decoded into v and closed.
+func (c *Client) doGetResponse(req *http.Request, v interface{}) (*http.Response, error) {
 	var resp *http.Response
 	var err error
 	retries := c.retries
@@ -503,7 +513,8 @@ func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, er
 	if v != nil {
 		decoder := json.NewDecoder(resp.Body)
 		err := decoder.Decode(&v)
-		if err != nil {
+		// An accepted request may not have a body until it is processed
+		if err != nil && !(err == io.EOF && resp.StatusCode == http.StatusAccepted) {
 			return nil, err
 		}
 	}
@@ -515,7 +526,7 @@ func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, er
 
 	c.RateLimits.RetryAfterSeconds, _ = strconv.ParseFloat(resp.Header.Get("Retry-After"), 64)
 
-	return resp.Header, nil
+	return resp, nil
 }
 
 func (c *Client) logRequest(req *http.Request) {
@@ -720,6 +731,16 @@ func (c *Client) CreateAndDo(method, relPath string, data, options, resource int
 
 // createAndDoGetHeaders creates an executes a request while returning the response headers.
 func (c *Client) createAndDoGetHeaders(method, relPath string, data, options, resource interface{}) (http.Header, error) {
+	resp, err := c.createAndDoGetResponse(method, relPath, data, options, resource)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Header, nil
+}
+
+// createAndDoGetResponse creates and executes a request while returning the
+// response, for callers that need its status code.
+func (c *Client) createAndDoGetResponse(method, relPath string, data, options, resource interface{}) (*http.Response, error) {
 	if strings.HasPrefix(relPath, "/") {
 		// make sure it's a relative path
 		relPath = strings.TrimLeft(relPath, "/")
@@ -731,7 +752,7 @@ func (c *Client) createAndDoGetHeaders(method, relPath string, data, options, re
 		return nil, err
 	}
 
-	return c.doGetHeaders(req, resource)
+	return c.doGetResponse(req, resource)
 }
 
 // Get performs a GET request for the given path and saves the result in the
@@ -9,28 +9,36 @@ import (
 
 const inventoryLevelBasePath = "inventory_levels"
 
+// InventoryLevelService is an interface for interacting with the
+// inventory levels endpoints of the Shopify API
+// See https://shopify.dev/api/admin-rest/latest/resources/inventorylevel
 type InventoryLevelService interface {
 	List(interface{}) ([]InventoryLevel, error)
 	ListWithPagination(interface{}) ([]InventoryLevel, *Pagination, error)
+	Adjust(InventoryLevelAdjustOptions) (*InventoryLevel, error)
+	Connect(InventoryLevelConnectOptions) (*InventoryLevel, error)
+	Set(InventoryLevelSetOptions) (*InventoryLevel, error)
+	Delete(int64, int64) error
 	GetOrderList() []string
 }
 
+// InventoryLevelServiceOp is the default implementation of the InventoryLevelService interface
 type InventoryLevelServiceOp struct {
 	client *Client
 }
 
+// InventoryLevel represents the quantity of an inventory item at a location
 type InventoryLevel struct {
-	ID           int64      `json:"id"`
-	SubjectID    int64      `json:"subject_id"`
-	CreatedAt    *time.Time `json:"created_at"`
-	Subject_type string     `json:"subject_type"`
-	Verb         string     `json:"verb"`
-	Arguments    []string   `json:"arguments"`
-	Body         string     `json:"body"`
-	Message      string     `json:"message"`
-	Author       string     `json:"author"`
-	Description  string     `json:"description"`
-	Path         string     `json:"path"`
+	InventoryItemID   int64      `json:"inventory_item_id"`
+	LocationID        int64      `json:"location_id"`
+	Available         int        `json:"available"`
+	UpdatedAt         *time.Time `json:"updated_at"`
+	AdminGraphqlAPIID string     `json:"admin_graphql_api_id"`
+}
+
+// InventoryLevelResource is used for handling single level requests and responses
+type InventoryLevelResource struct {
+	InventoryLevel *InventoryLevel `json:"inventory_level"`
 }
 
 // InventoryLevelsResource represents the result from the
@@ -39,6 +47,39 @@ type InventoryLevelsResource struct {
 	InventoryLevels []InventoryLevel `json:"inventory_levels"`
 }
 
+// InventoryLevelAdjustOptions adjusts the available quantity of an inventory
+// item at a location by AvailableAdjustment, which may be negative.
+type InventoryLevelAdjustOptions struct {
+	InventoryItemID     int64 `json:"inventory_item_id"`
+	LocationID          int64 `json:"location_id"`
+	AvailableAdjustment int   `json:"available_adjustment"`
+}
+
+// InventoryLevelConnectOptions connects an inventory item to a location.
+// RelocateIfNecessary moves the item away from a fulfillment service location
+// it is stocked at, if any.
+type InventoryLevelConnectOptions struct {
+	InventoryItemID     int64 `json:"inventory_item_id"`
+	LocationID          int64 `json:"location_id"`
+	RelocateIfNecessary bool  `json:"relocate_if_necessary,omitempty"`
+}
+
+// InventoryLevelSetOptions sets the available quantity of an inventory item
+// at a location. DisconnectIfNecessary disconnects the item from any
+// fulfillment service location it is stocked at, if any.
+type InventoryLevelSetOptions struct {
+	InventoryItemID       int64 `json:"inventory_item_id"`
+	LocationID            int64 `json:"location_id"`
+	Available             int   `json:"available"`
+	DisconnectIfNecessary bool  `json:"disconnect_if_necessary,omitempty"`
+}
+
+// inventoryLevelDeleteOptions identifies the inventory level to delete.
+type inventoryLevelDeleteOptions struct {
+	InventoryItemID int64 `url:"inventory_item_id"`
+	LocationID      int64 `url:"location_id"`
+}
+
 type InventoryLevelsOptions struct {
 	// PageInfo is used with new pagination search.
 	PageInfo string `url:"page_info,omitempty"`
@@ -59,10 +100,11 @@ type InventoryLevelsOptions struct {
 }
 
 // List gets all application inventoryLevels.
-func (s InventoryLevelServiceOp) List(options interface{}) ([]InventoryLevel, error) {
+func (s *InventoryLevelServiceOp) List(options interface{}) ([]InventoryLevel, error) {
 	path := fmt.Sprintf("%s.json", inventoryLevelBasePath)
-	resource := &InventoryLevelsResource{}
-	return resource.InventoryLevels, s.client.Get(path, resource, options)
+	resource := new(InventoryLevelsResource)
+	err := s.client.Get(path, resource, options)
+	return resource.InventoryLevels, err
 }
 
 func (s *InventoryLevelServiceOp) ListWithPagination(options interface{}) ([]InventoryLevel, *Pagination, error) {
@@ -86,6 +128,38 @@ func (s *InventoryLevelServiceOp) ListWithPagination(options interface{}) ([]Inv
 	return resource.InventoryLevels, pagination, nil
 }
 
+// Adjust the available quantity of an inventory item at a location
+func (s *InventoryLevelServiceOp) Adjust(options InventoryLevelAdjustOptions) (*InventoryLevel, error) {
+	path := fmt.Sprintf("%s/adjust.json", inventoryLevelBasePath)
+	resource := new(InventoryLevelResource)
+	err := s.client.Post(path, options, resource)
+	return resource.InventoryLevel, err
+}
+
+// Connect an inventory item to a location
+func (s *InventoryLevelServiceOp) Connect(options InventoryLevelConnectOptions) (*InventoryLevel, error) {
+	path := fmt.Sprintf("%s/connect.json", inventoryLevelBasePath)
+	resource := new(InventoryLevelResource)
+	err := s.client.Post(path, options, resource)
+	return resource.InventoryLevel, err
+}
+
+// Set the available quantity of an inventory item at a location
+func (s *InventoryLevelServiceOp) Set(options InventoryLevelSetOptions) (*InventoryLevel, error) {
+	path := fmt.Sprintf("%s/set.json", inventoryLevelBasePath)
+	resource := new(InventoryLevelResource)
+	err := s.client.Post(path, options, resource)
+	return resource.InventoryLevel, err
+}
+
+// Delete the inventory level of an inventory item at a location, which
+// disconnects the item from the location
+func (s *InventoryLevelServiceOp) Delete(inventoryItemID, locationID int64) error {
+	path := fmt.Sprintf("%s.json", inventoryLevelBasePath)
+	options := inventoryLevelDeleteOptions{InventoryItemID: inventoryItemID, LocationID: locationID}
+	return s.client.CreateAndDo("DELETE", path, nil, options, nil)
+}
+
 func (s *InventoryLevelServiceOp) GetOrderList() []string {
 	str := new(InventoryLevel)
 
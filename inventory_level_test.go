@@ -0,0 +1,150 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func inventoryLevelTests(t *testing.T, level *InventoryLevel) {
+	if level == nil {
+		t.Fatalf("InventoryLevel is nil")
+	}
+
+	expected := InventoryLevel{
+		InventoryItemID:   808950810,
+		LocationID:        905684977,
+		Available:         6,
+		AdminGraphqlAPIID: "gid://shopify/InventoryLevel/905684977?inventory_item_id=808950810",
+	}
+	d := time.Date(2021, time.September, 19, 20, 2, 39, 0, time.UTC)
+	if level.UpdatedAt == nil || !d.Equal(*level.UpdatedAt) {
+		t.Errorf("InventoryLevel.UpdatedAt returned %+v, expected %+v", level.UpdatedAt, d)
+	}
+	level.UpdatedAt = nil
+	if !reflect.DeepEqual(*level, expected) {
+		t.Errorf("InventoryLevel returned %+v, expected %+v", *level, expected)
+	}
+}
+
+// inventoryLevelResponder checks the JSON request body before responding with
+// the inventory_level.json fixture.
+func inventoryLevelResponder(t *testing.T, expectedBody map[string]interface{}) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(req.Body)
+		got := map[string]interface{}{}
+		json.Unmarshal(body, &got)
+		if !reflect.DeepEqual(got, expectedBody) {
+			t.Errorf("InventoryLevel request body %s, expected %+v", body, expectedBody)
+		}
+		return httpmock.NewBytesResponse(200, loadFixture("inventory_level.json")), nil
+	}
+}
+
+func TestInventoryLevelsList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"location_ids": "905684977"}
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_levels.json", client.pathPrefix),
+		params, httpmock.NewBytesResponder(200, loadFixture("inventory_levels.json")))
+
+	levels, err := client.InventoryLevel.List(InventoryLevelsOptions{LocationIDs: []int64{905684977}})
+	if err != nil {
+		t.Errorf("InventoryLevel.List returned error: %v", err)
+	}
+
+	if len(levels) != 2 {
+		t.Fatalf("InventoryLevel.List got %d levels, expected 2", len(levels))
+	}
+	inventoryLevelTests(t, &levels[0])
+}
+
+func TestInventoryLevelAdjust(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_levels/adjust.json", client.pathPrefix),
+		inventoryLevelResponder(t, map[string]interface{}{
+			"inventory_item_id":    float64(808950810),
+			"location_id":          float64(905684977),
+			"available_adjustment": float64(-2),
+		}))
+
+	level, err := client.InventoryLevel.Adjust(InventoryLevelAdjustOptions{
+		InventoryItemID:     808950810,
+		LocationID:          905684977,
+		AvailableAdjustment: -2,
+	})
+	if err != nil {
+		t.Errorf("InventoryLevel.Adjust returned error: %v", err)
+	}
+
+	inventoryLevelTests(t, level)
+}
+
+func TestInventoryLevelConnect(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_levels/connect.json", client.pathPrefix),
+		inventoryLevelResponder(t, map[string]interface{}{
+			"inventory_item_id":     float64(808950810),
+			"location_id":           float64(905684977),
+			"relocate_if_necessary": true,
+		}))
+
+	level, err := client.InventoryLevel.Connect(InventoryLevelConnectOptions{
+		InventoryItemID:     808950810,
+		LocationID:          905684977,
+		RelocateIfNecessary: true,
+	})
+	if err != nil {
+		t.Errorf("InventoryLevel.Connect returned error: %v", err)
+	}
+
+	inventoryLevelTests(t, level)
+}
+
+func TestInventoryLevelSet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_levels/set.json", client.pathPrefix),
+		inventoryLevelResponder(t, map[string]interface{}{
+			"inventory_item_id": float64(808950810),
+			"location_id":       float64(905684977),
+			"available":         float64(6),
+		}))
+
+	level, err := client.InventoryLevel.Set(InventoryLevelSetOptions{
+		InventoryItemID: 808950810,
+		LocationID:      905684977,
+		Available:       6,
+	})
+	if err != nil {
+		t.Errorf("InventoryLevel.Set returned error: %v", err)
+	}
+
+	inventoryLevelTests(t, level)
+}
+
+func TestInventoryLevelDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"inventory_item_id": "808950810", "location_id": "905684977"}
+	httpmock.RegisterResponderWithQuery("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/inventory_levels.json", client.pathPrefix),
+		params, httpmock.NewStringResponder(204, ""))
+
+	err := client.InventoryLevel.Delete(808950810, 905684977)
+	if err != nil {
+		t.Errorf("InventoryLevel.Delete returned error: %v", err)
+	}
+}
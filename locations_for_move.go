@@ -19,7 +19,7 @@ type LocationsForMoveServiceOp struct {
 }
 
 type LocationsForMove struct {
-	Location *LocationsForMoveLocation `json:"destination"`
+	Location *LocationsForMoveLocation `json:"location"`
 	Message  string                    `json:"message"`
 	Movable  bool                      `json:"movable"`
 }
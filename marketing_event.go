@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -18,6 +19,11 @@ type MarketingEventService interface {
 	Get(int64, interface{}) (*MarketingEvent, error)
 	List(interface{}) ([]MarketingEvent, error)
 	ListWithPagination(interface{}) ([]MarketingEvent, *Pagination, error)
+	Count(interface{}) (int, error)
+	Create(MarketingEvent) (*MarketingEvent, error)
+	Update(MarketingEvent) (*MarketingEvent, error)
+	Delete(int64) error
+	CreateEngagements(int64, []MarketingEngagement) ([]MarketingEngagement, error)
 	GetOrderList() []string
 }
 
@@ -55,6 +61,78 @@ type MarketedResources struct {
 	Type string `json:"type"`
 }
 
+// MarketingEngagement represents the engagement metrics of a marketing event
+// on a single day. Metrics left nil are not sent, so a zero count can be
+// reported. When IsCumulative is set the metrics are totals since the start of
+// the event rather than daily values.
+type MarketingEngagement struct {
+	OccurredOn        time.Time        `json:"-"`
+	ImpressionsCount  *int             `json:"impressions_count,omitempty"`
+	ViewsCount        *int             `json:"views_count,omitempty"`
+	UniqueViewsCount  *int             `json:"unique_views_count,omitempty"`
+	ClicksCount       *int             `json:"clicks_count,omitempty"`
+	UniqueClicksCount *int             `json:"unique_clicks_count,omitempty"`
+	FavoritesCount    *int             `json:"favorites_count,omitempty"`
+	CommentsCount     *int             `json:"comments_count,omitempty"`
+	SharesCount       *int             `json:"shares_count,omitempty"`
+	SendsCount        *int             `json:"sends_count,omitempty"`
+	FailsCount        *int             `json:"fails_count,omitempty"`
+	UnsubscribesCount *int             `json:"unsubscribes_count,omitempty"`
+	ComplaintsCount   *int             `json:"complaints_count,omitempty"`
+	AdSpend           *decimal.Decimal `json:"ad_spend,omitempty"`
+	IsCumulative      bool             `json:"is_cumulative"`
+	UTCOffset         string           `json:"utc_offset,omitempty"`
+	FetchedAt         *time.Time       `json:"fetched_at,omitempty"`
+}
+
+// marketingEngagementDateFormat is the format of occurred_on
+const marketingEngagementDateFormat = "2006-01-02"
+
+// MarshalJSON writes OccurredOn as the calendar day the metrics belong to,
+// which is read in the UTCOffset of the engagement. A zero OccurredOn is left
+// out.
+func (e MarketingEngagement) MarshalJSON() ([]byte, error) {
+	type alias MarketingEngagement
+	aux := struct {
+		OccurredOn string `json:"occurred_on,omitempty"`
+		alias
+	}{alias: alias(e)}
+
+	if !e.OccurredOn.IsZero() {
+		aux.OccurredOn = e.OccurredOn.Format(marketingEngagementDateFormat)
+	}
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON parses occurred_on, which Shopify sends as a date.
+func (e *MarketingEngagement) UnmarshalJSON(data []byte) error {
+	type alias MarketingEngagement
+	aux := &struct {
+		OccurredOn string `json:"occurred_on"`
+		*alias
+	}{alias: (*alias)(e)}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	if aux.OccurredOn == "" {
+		return nil
+	}
+
+	occurredOn, err := time.Parse(marketingEngagementDateFormat, aux.OccurredOn)
+	if err != nil {
+		return err
+	}
+	e.OccurredOn = occurredOn
+	return nil
+}
+
+// MarketingEngagementsResource represents the result from the
+// marketing_events/X/engagements.json endpoint
+type MarketingEngagementsResource struct {
+	Engagements []MarketingEngagement `json:"engagements"`
+}
+
 // MarketingEventResource represents the result from the
 // admin/marketingEvents{/X{/activate.json}.json}.json endpoints.
 type MarketingEventResource struct {
@@ -71,14 +149,16 @@ type MarketingEventsResource struct {
 func (s MarketingEventServiceOp) Get(marketingEventID int64, options interface{}) (*MarketingEvent, error) {
 	path := fmt.Sprintf("%s/%d.json", marketingEventBasePath, marketingEventID)
 	resource := &MarketingEventResource{}
-	return resource.MarketingEvent, s.client.Get(path, resource, options)
+	err := s.client.Get(path, resource, options)
+	return resource.MarketingEvent, err
 }
 
 // List gets all application marketingEvents.
 func (s MarketingEventServiceOp) List(options interface{}) ([]MarketingEvent, error) {
 	path := fmt.Sprintf("%s.json", marketingEventBasePath)
 	resource := &MarketingEventsResource{}
-	return resource.MarketingEvents, s.client.Get(path, resource, options)
+	err := s.client.Get(path, resource, options)
+	return resource.MarketingEvents, err
 }
 
 func (s *MarketingEventServiceOp) ListWithPagination(options interface{}) ([]MarketingEvent, *Pagination, error) {
@@ -102,6 +182,50 @@ func (s *MarketingEventServiceOp) ListWithPagination(options interface{}) ([]Mar
 	return resource.MarketingEvents, pagination, nil
 }
 
+// Count marketing events
+func (s *MarketingEventServiceOp) Count(options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", marketingEventBasePath)
+	return s.client.Count(path, options)
+}
+
+// Create a new marketing event
+func (s *MarketingEventServiceOp) Create(marketingEvent MarketingEvent) (*MarketingEvent, error) {
+	path := fmt.Sprintf("%s.json", marketingEventBasePath)
+	wrappedData := MarketingEventResource{MarketingEvent: &marketingEvent}
+	resource := new(MarketingEventResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.MarketingEvent, err
+}
+
+// Update an existing marketing event
+func (s *MarketingEventServiceOp) Update(marketingEvent MarketingEvent) (*MarketingEvent, error) {
+	path := fmt.Sprintf("%s/%d.json", marketingEventBasePath, marketingEvent.ID)
+	wrappedData := MarketingEventResource{MarketingEvent: &marketingEvent}
+	resource := new(MarketingEventResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.MarketingEvent, err
+}
+
+// Delete an existing marketing event
+func (s *MarketingEventServiceOp) Delete(marketingEventID int64) error {
+	return s.client.Delete(fmt.Sprintf("%s/%d.json", marketingEventBasePath, marketingEventID))
+}
+
+// CreateEngagements reports engagement metrics of a marketing event. Every
+// engagement needs the day it occurred on.
+func (s *MarketingEventServiceOp) CreateEngagements(marketingEventID int64, engagements []MarketingEngagement) ([]MarketingEngagement, error) {
+	for i, engagement := range engagements {
+		if engagement.OccurredOn.IsZero() {
+			return nil, fmt.Errorf("marketing engagement %d has no occurred on date", i)
+		}
+	}
+	path := fmt.Sprintf("%s/%d/engagements.json", marketingEventBasePath, marketingEventID)
+	wrappedData := MarketingEngagementsResource{Engagements: engagements}
+	resource := new(MarketingEngagementsResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Engagements, err
+}
+
 func (s *MarketingEventServiceOp) GetOrderList() []string {
 	str := new(MarketingEvent)
 
@@ -0,0 +1,175 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+const marketingEventResponse = `{"marketing_event":{"id":1,"event_type":"ad","remote_id":"1000:2000","started_at":"2022-12-15T00:00:00-05:00","budget":"10.11","currency":"GBP","utm_campaign":"1234567890","utm_source":"facebook","utm_medium":"cpc","budget_type":"daily","marketing_channel":"social","paid":true}}`
+
+func TestMarketingEventGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/marketing_events/1.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, marketingEventResponse))
+
+	marketingEvent, err := client.MarketingEvent.Get(1, nil)
+	if err != nil {
+		t.Fatalf("MarketingEvent.Get returned error: %v", err)
+	}
+
+	if marketingEvent.ID != 1 || marketingEvent.UtmSource != "facebook" {
+		t.Errorf("MarketingEvent.Get returned %+v, expected marketing event 1", marketingEvent)
+	}
+}
+
+func TestMarketingEventCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/marketing_events/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.MarketingEvent.Count(nil)
+	if err != nil {
+		t.Errorf("MarketingEvent.Count returned error: %v", err)
+	}
+
+	expected := 2
+	if cnt != expected {
+		t.Errorf("MarketingEvent.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestMarketingEventCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/marketing_events.json", client.pathPrefix),
+		httpmock.NewStringResponder(201, marketingEventResponse))
+
+	budget := decimal.RequireFromString("10.11")
+	startedAt := time.Date(2022, time.December, 15, 5, 0, 0, 0, time.UTC)
+	marketingEvent, err := client.MarketingEvent.Create(MarketingEvent{
+		StartedAt:        &startedAt,
+		UtmCampaign:      "1234567890",
+		UtmSource:        "facebook",
+		UtmMedium:        "cpc",
+		EventType:        "ad",
+		RemoteID:         "1000:2000",
+		Budget:           &budget,
+		Currency:         "GBP",
+		BudgetType:       "daily",
+		MarketingChannel: "social",
+		Paid:             true,
+	})
+	if err != nil {
+		t.Fatalf("MarketingEvent.Create returned error: %v", err)
+	}
+
+	if marketingEvent.ID != 1 || !marketingEvent.Budget.Equal(budget) {
+		t.Errorf("MarketingEvent.Create returned %+v, expected marketing event 1", marketingEvent)
+	}
+}
+
+func TestMarketingEventUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/marketing_events/1.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, marketingEventResponse))
+
+	marketingEvent, err := client.MarketingEvent.Update(MarketingEvent{ID: 1, RemoteID: "1000:2000"})
+	if err != nil {
+		t.Fatalf("MarketingEvent.Update returned error: %v", err)
+	}
+
+	if marketingEvent.ID != 1 {
+		t.Errorf("MarketingEvent.ID returned %+v, expected %+v", marketingEvent.ID, 1)
+	}
+}
+
+func TestMarketingEventDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/marketing_events/1.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.MarketingEvent.Delete(1)
+	if err != nil {
+		t.Errorf("MarketingEvent.Delete returned error: %v", err)
+	}
+}
+
+func TestMarketingEventCreateEngagements(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body map[string][]map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/marketing_events/1/engagements.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(201, `{"engagements":[{"occurred_on":"2017-04-20","views_count":10,"clicks_count":5,"ad_spend":"10.0","is_cumulative":true}]}`), nil
+		})
+
+	adSpend := decimal.NewFromInt(10)
+	views, clicks := 10, 0
+	engagement := MarketingEngagement{
+		OccurredOn:   time.Date(2017, time.April, 20, 0, 0, 0, 0, time.UTC),
+		ViewsCount:   &views,
+		ClicksCount:  &clicks,
+		AdSpend:      &adSpend,
+		IsCumulative: true,
+	}
+
+	engagements, err := client.MarketingEvent.CreateEngagements(1, []MarketingEngagement{engagement})
+	if err != nil {
+		t.Fatalf("MarketingEvent.CreateEngagements returned error: %v", err)
+	}
+
+	expectedSent := map[string]interface{}{
+		"occurred_on":   "2017-04-20",
+		"views_count":   float64(10),
+		"clicks_count":  float64(0),
+		"ad_spend":      "10",
+		"is_cumulative": true,
+	}
+	if len(body["engagements"]) != 1 || !reflect.DeepEqual(body["engagements"][0], expectedSent) {
+		t.Errorf("MarketingEvent.CreateEngagements sent %+v, expected %+v", body["engagements"], expectedSent)
+	}
+
+	if len(engagements) != 1 || !engagements[0].OccurredOn.Equal(engagement.OccurredOn) ||
+		engagements[0].ViewsCount == nil || *engagements[0].ViewsCount != 10 || engagements[0].SharesCount != nil {
+		t.Errorf("MarketingEvent.CreateEngagements returned %+v, expected %+v", engagements, engagement)
+	}
+}
+
+func TestMarketingEventCreateEngagementsWithoutDate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	views := 10
+	_, err := client.MarketingEvent.CreateEngagements(1, []MarketingEngagement{{ViewsCount: &views}})
+	if err == nil {
+		t.Errorf("MarketingEvent.CreateEngagements expected an error for an engagement without a date")
+	}
+
+	data, err := json.Marshal(MarketingEngagement{ViewsCount: &views})
+	if err != nil {
+		t.Fatalf("MarketingEngagement.MarshalJSON returned error: %v", err)
+	}
+	if expected := `{"views_count":10,"is_cumulative":false}`; string(data) != expected {
+		t.Errorf("MarketingEngagement.MarshalJSON returned %s, expected %s", data, expected)
+	}
+}
@@ -10,9 +10,23 @@ import (
 
 const orderRiskBasePath = "orders/%d/risks"
 
+// Recommended actions of an order risk.
+const (
+	RiskRecommendationAccept      = "accept"
+	RiskRecommendationInvestigate = "investigate"
+	RiskRecommendationCancel      = "cancel"
+)
+
+// OrderRiskService is an interface for interfacing with the order risk
+// endpoints of the Shopify API.
+// See: https://shopify.dev/api/admin-rest/latest/resources/order-risk
 type OrderRiskService interface {
 	List(int64) ([]Risk, error)
 	ListWithPagination(int64, interface{}) ([]Risk, *Pagination, error)
+	Get(int64, int64) (*Risk, error)
+	Create(int64, Risk) (*Risk, error)
+	Update(int64, Risk) (*Risk, error)
+	Delete(int64, int64) error
 	GetOrderList() []string
 }
 
@@ -26,18 +40,42 @@ type Risk struct {
 	CheckoutID      int64            `json:"checkout_id"`
 	Source          string           `json:"source"`
 	Score           *decimal.Decimal `json:"score"`
-	Recommendation  string           `json:"recommendation"`
+	Recommendation  string           `json:"recommendation,omitempty"`
 	Display         bool             `json:"display"`
 	CauseCancel     bool             `json:"cause_cancel"`
 	Message         string           `json:"message"`
 	MerchantMessage string           `json:"merchant_message"`
 }
 
+type RiskResource struct {
+	Risk *Risk `json:"risk"`
+}
+
 type RisksResource struct {
 	Risks []Risk `json:"risks"`
 }
 
-// List of discount codes
+// Validate checks the recommendation and score of a risk before it is sent
+func (r Risk) Validate() error {
+	switch r.Recommendation {
+	case RiskRecommendationAccept, RiskRecommendationInvestigate, RiskRecommendationCancel:
+	default:
+		return fmt.Errorf("invalid risk recommendation %q, expected %s, %s or %s",
+			r.Recommendation, RiskRecommendationAccept, RiskRecommendationInvestigate, RiskRecommendationCancel)
+	}
+
+	return r.validateScore()
+}
+
+func (r Risk) validateScore() error {
+	if r.Score != nil && (r.Score.IsNegative() || r.Score.GreaterThan(decimal.NewFromInt(1))) {
+		return fmt.Errorf("invalid risk score %s, expected a value between 0 and 1", r.Score)
+	}
+
+	return nil
+}
+
+// List risks of an order
 func (s *OrderRiskServiceOp) List(orderID int64) ([]Risk, error) {
 	path := fmt.Sprintf(orderRiskBasePath+".json", orderID)
 	resource := new(RisksResource)
@@ -66,6 +104,49 @@ func (s *OrderRiskServiceOp) ListWithPagination(orderID int64, options interface
 	return resource.Risks, pagination, nil
 }
 
+// Get individual risk
+func (s *OrderRiskServiceOp) Get(orderID int64, riskID int64) (*Risk, error) {
+	path := fmt.Sprintf(orderRiskBasePath+"/%d.json", orderID, riskID)
+	resource := new(RiskResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.Risk, err
+}
+
+// Create a new risk for an order
+func (s *OrderRiskServiceOp) Create(orderID int64, risk Risk) (*Risk, error) {
+	if err := risk.Validate(); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf(orderRiskBasePath+".json", orderID)
+	wrappedData := RiskResource{Risk: &risk}
+	resource := new(RiskResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Risk, err
+}
+
+// Update an existing risk. Only risks created by the app can be updated. The
+// recommendation is only checked when it is set, so that other fields such as
+// the message can be updated on their own.
+func (s *OrderRiskServiceOp) Update(orderID int64, risk Risk) (*Risk, error) {
+	validate := risk.Validate
+	if risk.Recommendation == "" {
+		validate = risk.validateScore
+	}
+	if err := validate(); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf(orderRiskBasePath+"/%d.json", orderID, risk.ID)
+	wrappedData := RiskResource{Risk: &risk}
+	resource := new(RiskResource)
+	err := s.client.Put(path, wrappedData, resource)
+	return resource.Risk, err
+}
+
+// Delete an existing risk. Only risks created by the app can be deleted.
+func (s *OrderRiskServiceOp) Delete(orderID int64, riskID int64) error {
+	return s.client.Delete(fmt.Sprintf(orderRiskBasePath+"/%d.json", orderID, riskID))
+}
+
 func (s *OrderRiskServiceOp) GetOrderList() []string {
 	str := new(Risk)
 
@@ -0,0 +1,172 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+const orderRiskResponse = `{"risk":{"id":284138680,"order_id":450789469,"checkout_id":null,"source":"External","score":"1.0","recommendation":"cancel","display":true,"cause_cancel":true,"message":"This order was placed from a proxy IP","merchant_message":"This order was placed from a proxy IP"}}`
+
+func TestOrderRiskGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/risks/284138680.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, orderRiskResponse),
+	)
+
+	risk, err := client.OrderRisk.Get(450789469, 284138680)
+	if err != nil {
+		t.Fatalf("OrderRisk.Get returned error: %v", err)
+	}
+
+	if risk.ID != 284138680 || risk.OrderID != 450789469 {
+		t.Errorf("OrderRisk.Get returned %+v, expected id 284138680", risk)
+	}
+	if risk.Score == nil || !risk.Score.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("Risk.Score = %v, expected 1", risk.Score)
+	}
+	if risk.Recommendation != RiskRecommendationCancel || !risk.CauseCancel || !risk.Display {
+		t.Errorf("OrderRisk.Get returned %+v, expected a displayed cancel recommendation", risk)
+	}
+}
+
+func TestOrderRiskCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/risks.json", client.pathPrefix),
+		httpmock.NewStringResponder(201, orderRiskResponse),
+	)
+
+	score := decimal.NewFromInt(1)
+	risk, err := client.OrderRisk.Create(450789469, Risk{
+		Source:         "External",
+		Score:          &score,
+		Recommendation: RiskRecommendationCancel,
+		Display:        true,
+		CauseCancel:    true,
+		Message:        "This order was placed from a proxy IP",
+	})
+	if err != nil {
+		t.Fatalf("OrderRisk.Create returned error: %v", err)
+	}
+
+	if risk.ID != 284138680 {
+		t.Errorf("Risk.ID returned %+v, expected %+v", risk.ID, 284138680)
+	}
+}
+
+func TestOrderRiskUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"PUT",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/risks/284138680.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, orderRiskResponse),
+	)
+
+	risk, err := client.OrderRisk.Update(450789469, Risk{
+		ID:             284138680,
+		Recommendation: RiskRecommendationCancel,
+	})
+	if err != nil {
+		t.Fatalf("OrderRisk.Update returned error: %v", err)
+	}
+
+	if risk.ID != 284138680 {
+		t.Errorf("Risk.ID returned %+v, expected %+v", risk.ID, 284138680)
+	}
+}
+
+func TestOrderRiskUpdateMessage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body map[string]map[string]interface{}
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/risks/284138680.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			return httpmock.NewStringResponse(200, orderRiskResponse), nil
+		})
+
+	_, err := client.OrderRisk.Update(450789469, Risk{
+		ID:      284138680,
+		Message: "The billing address is a known reshipper",
+	})
+	if err != nil {
+		t.Fatalf("OrderRisk.Update returned error: %v", err)
+	}
+
+	if body["risk"]["message"] != "The billing address is a known reshipper" {
+		t.Errorf("OrderRisk.Update sent message %v", body["risk"]["message"])
+	}
+	if _, ok := body["risk"]["recommendation"]; ok {
+		t.Errorf("OrderRisk.Update sent recommendation %v, expected it to be left out", body["risk"]["recommendation"])
+	}
+}
+
+func TestOrderRiskDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"DELETE",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/risks/284138680.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"),
+	)
+
+	err := client.OrderRisk.Delete(450789469, 284138680)
+	if err != nil {
+		t.Errorf("OrderRisk.Delete returned error: %v", err)
+	}
+}
+
+func TestRiskValidate(t *testing.T) {
+	low := decimal.NewFromFloat(0.2)
+	negative := decimal.NewFromInt(-1)
+	high := decimal.NewFromFloat(1.5)
+
+	cases := []struct {
+		risk  Risk
+		valid bool
+	}{
+		{Risk{Recommendation: RiskRecommendationAccept}, true},
+		{Risk{Recommendation: RiskRecommendationInvestigate, Score: &low}, true},
+		{Risk{Recommendation: RiskRecommendationCancel}, true},
+		{Risk{Recommendation: ""}, false},
+		{Risk{Recommendation: "refuse"}, false},
+		{Risk{Recommendation: RiskRecommendationAccept, Score: &negative}, false},
+		{Risk{Recommendation: RiskRecommendationAccept, Score: &high}, false},
+	}
+
+	for _, c := range cases {
+		err := c.risk.Validate()
+		if (err == nil) != c.valid {
+			t.Errorf("Risk{Recommendation: %q, Score: %v}.Validate() returned %v, expected valid %v",
+				c.risk.Recommendation, c.risk.Score, err, c.valid)
+		}
+	}
+}
+
+func TestOrderRiskCreateInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.OrderRisk.Create(450789469, Risk{Recommendation: "refuse"})
+	if err == nil {
+		t.Errorf("OrderRisk.Create expected an error for an invalid recommendation")
+	}
+}
@@ -1,6 +1,7 @@
 package goshopify
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -11,9 +12,19 @@ import (
 
 const payoutsBasePath = "shopify_payments/payouts"
 
+// payoutTransactionsPageSize is the number of balance transactions requested
+// per page when listing the transactions of a payout.
+const payoutTransactionsPageSize = 100
+
+// PayoutService is an interface for interfacing with the Shopify Payments
+// payout endpoints of the Shopify API.
+// See: https://shopify.dev/docs/admin-api/rest/reference/shopify_payments/payout
 type PayoutService interface {
 	List(interface{}) ([]Payout, error)
 	ListWithPagination(interface{}) ([]Payout, *Pagination, error)
+	Get(int64) (*Payout, error)
+	ListTransactions(int64) ([]ShopifyPaymentsTransactions, error)
+	Reconcile(int64) (*PayoutReconciliation, error)
 	GetOrderList() []string
 }
 
@@ -21,7 +32,7 @@ type PayoutServiceOp struct {
 	client *Client
 }
 
-// Payout represents a Shopify blog
+// Payout represents a Shopify Payments payout
 type Payout struct {
 	ID       int64            `json:"id"`
 	Status   string           `json:"status"`
@@ -44,6 +55,109 @@ type Summary struct {
 	RetriedPayoutsGrossAmount *decimal.Decimal `json:"retried_payouts_gross_amount"`
 }
 
+// UnmarshalJSON parses date, which Shopify sends as a date without a time.
+func (p *Payout) UnmarshalJSON(data []byte) error {
+	type alias Payout
+	aux := &struct {
+		Date *string `json:"date"`
+		*alias
+	}{alias: (*alias)(p)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	return parse(&p.Date, aux.Date)
+}
+
+// PayoutDiscrepancy is an amount of a payout that does not match the sum of
+// its balance transactions. Field is the json name of the payout amount.
+type PayoutDiscrepancy struct {
+	Field    string
+	Expected decimal.Decimal
+	Actual   decimal.Decimal
+}
+
+// PayoutReconciliation is a payout with all of its balance transactions and
+// the amounts that do not add up.
+type PayoutReconciliation struct {
+	Payout        *Payout
+	Transactions  []ShopifyPaymentsTransactions
+	Discrepancies []PayoutDiscrepancy
+}
+
+// Balanced returns true if the transactions add up to the payout amounts.
+func (r PayoutReconciliation) Balanced() bool {
+	return len(r.Discrepancies) == 0
+}
+
+// Discrepancies compares the amounts of the payout and its summary with the
+// sums of the given balance transactions. Transactions of type payout are the
+// payout itself and are ignored. Summary amounts left empty are treated as
+// zero.
+func (p Payout) Discrepancies(transactions []ShopifyPaymentsTransactions) []PayoutDiscrepancy {
+	var net, chargesFee, chargesGross, refundsFee, refundsGross, adjustmentsFee, adjustmentsGross,
+		reservedFee, reservedGross, retriedFee, retriedGross decimal.Decimal
+
+	for _, t := range transactions {
+		amount, fee := decimalOrZero(t.Amount), decimalOrZero(t.Fee)
+		switch t.Type {
+		case ShopifyPaymentsTransactionTypePayout:
+			continue
+		case ShopifyPaymentsTransactionTypeCharge:
+			chargesFee, chargesGross = chargesFee.Add(fee), chargesGross.Add(amount)
+		case ShopifyPaymentsTransactionTypeRefund:
+			refundsFee, refundsGross = refundsFee.Add(fee), refundsGross.Add(amount)
+		case ShopifyPaymentsTransactionTypeDispute, ShopifyPaymentsTransactionTypeAdjustment,
+			ShopifyPaymentsTransactionTypeCredit, ShopifyPaymentsTransactionTypeDebit:
+			adjustmentsFee, adjustmentsGross = adjustmentsFee.Add(fee), adjustmentsGross.Add(amount)
+		case ShopifyPaymentsTransactionTypeReserve:
+			reservedFee, reservedGross = reservedFee.Add(fee), reservedGross.Add(amount)
+		case ShopifyPaymentsTransactionTypePayoutFailure, ShopifyPaymentsTransactionTypePayoutCancellation:
+			retriedFee, retriedGross = retriedFee.Add(fee), retriedGross.Add(amount)
+		}
+		net = net.Add(decimalOrZero(t.Net))
+	}
+
+	var discrepancies []PayoutDiscrepancy
+	check := func(field string, expected *decimal.Decimal, actual decimal.Decimal) {
+		if !decimalOrZero(expected).Equal(actual) {
+			discrepancies = append(discrepancies, PayoutDiscrepancy{
+				Field:    field,
+				Expected: decimalOrZero(expected),
+				Actual:   actual,
+			})
+		}
+	}
+
+	check("amount", p.Amount, net)
+	if p.Summary != nil {
+		check("adjustments_fee_amount", p.Summary.AdjustmentsFeeAmount, adjustmentsFee)
+		check("adjustments_gross_amount", p.Summary.AdjustmentsGrossAmount, adjustmentsGross)
+		check("charges_fee_amount", p.Summary.ChargesFeeAmount, chargesFee)
+		check("charges_gross_amount", p.Summary.ChargesGrossAmount, chargesGross)
+		check("refunds_fee_amount", p.Summary.RefundsFeeAmount, refundsFee)
+		check("refunds_gross_amount", p.Summary.RefundsGrossAmount, refundsGross)
+		check("reserved_funds_fee_amount", p.Summary.ReservedFundsFeeAmount, reservedFee)
+		check("reserved_funds_gross_amount", p.Summary.ReservedFundsGrossAmount, reservedGross)
+		check("retried_payouts_fee_amount", p.Summary.RetriedPayoutsFeeAmount, retriedFee)
+		check("retried_payouts_gross_amount", p.Summary.RetriedPayoutsGrossAmount, retriedGross)
+	}
+
+	return discrepancies
+}
+
+func decimalOrZero(d *decimal.Decimal) decimal.Decimal {
+	if d == nil {
+		return decimal.Zero
+	}
+	return *d
+}
+
+// PayoutResource is the result from the payouts/X.json endpoint
+type PayoutResource struct {
+	Payout *Payout `json:"payout"`
+}
+
 // PayoutsResource is the result from the payouts.json endpoint
 type PayoutsResource struct {
 	Payouts []Payout `json:"payouts"`
@@ -78,6 +192,60 @@ func (s *PayoutServiceOp) ListWithPagination(options interface{}) ([]Payout, *Pa
 	return resource.Payouts, pagination, nil
 }
 
+// Get individual payout
+func (s *PayoutServiceOp) Get(payoutID int64) (*Payout, error) {
+	path := fmt.Sprintf("%s/%d.json", payoutsBasePath, payoutID)
+	resource := new(PayoutResource)
+	err := s.client.Get(path, resource, nil)
+	return resource.Payout, err
+}
+
+// ListTransactions returns all the balance transactions of a payout, going
+// through every page of results.
+func (s *PayoutServiceOp) ListTransactions(payoutID int64) ([]ShopifyPaymentsTransactions, error) {
+	var transactions []ShopifyPaymentsTransactions
+
+	var options interface{} = ShopifyPaymentsTransactionsListOptions{
+		ListOptions: ListOptions{Limit: payoutTransactionsPageSize},
+		PayoutID:    payoutID,
+	}
+	for {
+		page, pagination, err := s.client.ShopifyPaymentsTransactions.ListWithPagination(options)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, page...)
+
+		if pagination == nil || pagination.NextPageOptions == nil {
+			return transactions, nil
+		}
+		options = pagination.NextPageOptions
+	}
+}
+
+// Reconcile gets a payout and all of its balance transactions, and checks
+// that the transactions add up to the payout amount and summary.
+func (s *PayoutServiceOp) Reconcile(payoutID int64) (*PayoutReconciliation, error) {
+	payout, err := s.Get(payoutID)
+	if err != nil {
+		return nil, err
+	}
+	if payout == nil {
+		return nil, fmt.Errorf("payout %d was not returned", payoutID)
+	}
+
+	transactions, err := s.ListTransactions(payoutID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PayoutReconciliation{
+		Payout:        payout,
+		Transactions:  transactions,
+		Discrepancies: payout.Discrepancies(transactions),
+	}, nil
+}
+
 func (s *PayoutServiceOp) GetOrderList() []string {
 	str := new(Payout)
 
@@ -0,0 +1,162 @@
+package goshopify
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+const payoutResponse = `{"payout":{"id":623721858,"status":"paid","date":"2012-11-12","currency":"USD","amount":"41.88","summary":{"adjustments_fee_amount":"0.12","adjustments_gross_amount":"2.13","charges_fee_amount":"1.32","charges_gross_amount":"44.52","refunds_fee_amount":"-0.23","refunds_gross_amount":"-3.56","reserved_funds_fee_amount":"0.00","reserved_funds_gross_amount":"0.00","retried_payouts_fee_amount":"0.00","retried_payouts_gross_amount":"0.00"}}}`
+
+func registerPayoutTransactions() {
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/balance/transactions.json", client.pathPrefix),
+		map[string]string{"payout_id": "623721858", "limit": "100"},
+		httpmock.ResponderFromResponse(&http.Response{
+			StatusCode: 200,
+			Body: httpmock.NewRespBodyFromString(`{"transactions":[
+				{"id":1,"type":"charge","payout_id":623721858,"currency":"USD","amount":"40.00","fee":"1.20","net":"38.80"},
+				{"id":2,"type":"charge","payout_id":623721858,"currency":"USD","amount":"4.52","fee":"0.12","net":"4.40"}]}`),
+			Header: http.Header{
+				"Link": {`<http://valid.url?limit=100&page_info=pageInfoCode>; rel="next"`},
+			},
+		}),
+	)
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/balance/transactions.json", client.pathPrefix),
+		map[string]string{"page_info": "pageInfoCode", "limit": "100"},
+		httpmock.NewStringResponder(200, `{"transactions":[
+			{"id":3,"type":"adjustment","payout_id":623721858,"currency":"USD","amount":"2.13","fee":"0.12","net":"2.01"},
+			{"id":4,"type":"refund","payout_id":623721858,"currency":"USD","amount":"-3.56","fee":"-0.23","net":"-3.33"},
+			{"id":5,"type":"payout","payout_id":623721858,"currency":"USD","amount":"-41.88","fee":"0.00","net":"-41.88"}]}`),
+	)
+}
+
+func TestPayoutGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/payouts/623721858.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, payoutResponse),
+	)
+
+	payout, err := client.Payout.Get(623721858)
+	if err != nil {
+		t.Fatalf("Payout.Get returned error: %v", err)
+	}
+
+	if payout.ID != 623721858 || payout.Status != "paid" {
+		t.Errorf("Payout.Get returned %+v, expected payout 623721858", payout)
+	}
+
+	date := time.Date(2012, time.November, 12, 0, 0, 0, 0, time.UTC)
+	if payout.Date == nil || !payout.Date.Equal(date) {
+		t.Errorf("Payout.Date returned %v, expected %v", payout.Date, date)
+	}
+
+	if payout.Summary == nil || !payout.Summary.ChargesGrossAmount.Equal(decimal.RequireFromString("44.52")) {
+		t.Errorf("Payout.Summary returned %+v, expected charges gross amount 44.52", payout.Summary)
+	}
+}
+
+func TestPayoutListTransactions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerPayoutTransactions()
+
+	transactions, err := client.Payout.ListTransactions(623721858)
+	if err != nil {
+		t.Fatalf("Payout.ListTransactions returned error: %v", err)
+	}
+
+	if len(transactions) != 5 {
+		t.Fatalf("Payout.ListTransactions returned %d transactions, expected 5", len(transactions))
+	}
+	for i, transaction := range transactions {
+		if transaction.ID != int64(i+1) {
+			t.Errorf("Payout.ListTransactions returned transaction %d at %d, expected %d", transaction.ID, i, i+1)
+		}
+	}
+}
+
+func TestPayoutReconcile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/payouts/623721858.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, payoutResponse),
+	)
+	registerPayoutTransactions()
+
+	reconciliation, err := client.Payout.Reconcile(623721858)
+	if err != nil {
+		t.Fatalf("Payout.Reconcile returned error: %v", err)
+	}
+
+	if !reconciliation.Balanced() {
+		t.Errorf("Payout.Reconcile returned discrepancies %+v, expected none", reconciliation.Discrepancies)
+	}
+	if reconciliation.Payout.ID != 623721858 || len(reconciliation.Transactions) != 5 {
+		t.Errorf("Payout.Reconcile returned %+v, expected payout 623721858 with 5 transactions", reconciliation)
+	}
+}
+
+func TestPayoutDiscrepancies(t *testing.T) {
+	amount := decimal.RequireFromString("10.00")
+	chargesGross := decimal.RequireFromString("10.30")
+	chargesFee := decimal.RequireFromString("0.30")
+	payout := Payout{
+		Amount: &amount,
+		Summary: &Summary{
+			ChargesGrossAmount: &chargesGross,
+			ChargesFeeAmount:   &chargesFee,
+		},
+	}
+
+	gross := decimal.RequireFromString("10.30")
+	fee := decimal.RequireFromString("0.40")
+	net := decimal.RequireFromString("9.90")
+	transactions := []ShopifyPaymentsTransactions{
+		{Type: ShopifyPaymentsTransactionTypeCharge, Amount: &gross, Fee: &fee, Net: &net},
+	}
+
+	discrepancies := payout.Discrepancies(transactions)
+	if len(discrepancies) != 2 {
+		t.Fatalf("Payout.Discrepancies returned %+v, expected 2 discrepancies", discrepancies)
+	}
+
+	if discrepancies[0].Field != "amount" || !discrepancies[0].Actual.Equal(net) || !discrepancies[0].Expected.Equal(amount) {
+		t.Errorf("Payout.Discrepancies returned %+v, expected an amount discrepancy", discrepancies[0])
+	}
+	if discrepancies[1].Field != "charges_fee_amount" || !discrepancies[1].Actual.Equal(fee) || !discrepancies[1].Expected.Equal(chargesFee) {
+		t.Errorf("Payout.Discrepancies returned %+v, expected a charges fee discrepancy", discrepancies[1])
+	}
+}
+
+func TestPayoutReconcileMissingPayout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/payouts/623721858.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{}`),
+	)
+	registerPayoutTransactions()
+
+	reconciliation, err := client.Payout.Reconcile(623721858)
+	if err == nil {
+		t.Errorf("Payout.Reconcile returned %+v, expected an error when the payout is not returned", reconciliation)
+	}
+}
@@ -2,6 +2,7 @@ package goshopify
 
 import (
 	"fmt"
+	"net/http"
 	"reflect"
 	"time"
 
@@ -18,6 +19,8 @@ type PriceRuleService interface {
 	Create(PriceRule) (*PriceRule, error)
 	Update(PriceRule) (*PriceRule, error)
 	List() ([]PriceRule, error)
+	ListWithPagination(interface{}) ([]PriceRule, *Pagination, error)
+	Count(interface{}) (int, error)
 	Delete(int64) error
 	GetOrderList() []string
 }
@@ -52,27 +55,47 @@ type PriceRule struct {
 	PrerequisiteCollectionIDs              []int64                                 `json:"prerequisite_collection_ids"`
 	PrerequisiteSavedSearchIDs             []int64                                 `json:"prerequisite_saved_search_ids"`
 	PrerequisiteCustomerIDs                []int64                                 `json:"prerequisite_customer_ids"`
-	PrerequisiteSubtotalRange              *prerequisiteSubtotalRange              `json:"prerequisite_subtotal_range"`
-	PrerequisiteQuantityRange              *prerequisiteQuantityRange              `json:"prerequisite_quantity_range"`
-	PrerequisiteShippingPriceRange         *prerequisiteShippingPriceRange         `json:"prerequisite_shipping_price_range"`
-	PrerequisiteToEntitlementQuantityRatio *prerequisiteToEntitlementQuantityRatio `json:"prerequisite_to_entitlement_quantity_ratio"`
+	PrerequisiteSubtotalRange              *PrerequisiteSubtotalRange              `json:"prerequisite_subtotal_range"`
+	PrerequisiteQuantityRange              *PrerequisiteQuantityRange              `json:"prerequisite_quantity_range"`
+	PrerequisiteShippingPriceRange         *PrerequisiteShippingPriceRange         `json:"prerequisite_shipping_price_range"`
+	PrerequisiteToEntitlementQuantityRatio *PrerequisiteToEntitlementQuantityRatio `json:"prerequisite_to_entitlement_quantity_ratio"`
 	Title                                  string                                  `json:"title"`
 	AdminGraphqlAPIID                      string                                  `json:"admin_graphql_api_id"`
 }
 
-type prerequisiteSubtotalRange struct {
+// PriceRuleListOptions represents the options available when listing or
+// counting price rules.
+// See: https://shopify.dev/docs/admin-api/rest/reference/discounts/pricerule#index
+type PriceRuleListOptions struct {
+	ListOptions
+	StartsAtMin time.Time `url:"starts_at_min,omitempty"`
+	StartsAtMax time.Time `url:"starts_at_max,omitempty"`
+	EndsAtMin   time.Time `url:"ends_at_min,omitempty"`
+	EndsAtMax   time.Time `url:"ends_at_max,omitempty"`
+	TimesUsed   *int      `url:"times_used,omitempty"`
+}
+
+// PrerequisiteSubtotalRange is the minimum subtotal of a cart eligible for a
+// price rule
+type PrerequisiteSubtotalRange struct {
 	GreaterThanOrEqualTo string `json:"greater_than_or_equal_to,omitempty"`
 }
 
-type prerequisiteQuantityRange struct {
+// PrerequisiteQuantityRange is the minimum number of items of a cart eligible
+// for a price rule
+type PrerequisiteQuantityRange struct {
 	GreaterThanOrEqualTo int `json:"greater_than_or_equal_to,omitempty"`
 }
 
-type prerequisiteShippingPriceRange struct {
+// PrerequisiteShippingPriceRange is the maximum shipping price of a cart
+// eligible for a price rule
+type PrerequisiteShippingPriceRange struct {
 	LessThanOrEqualTo string `json:"less_than_or_equal_to,omitempty"`
 }
 
-type prerequisiteToEntitlementQuantityRatio struct {
+// PrerequisiteToEntitlementQuantityRatio is the number of prerequisite items
+// needed to get entitled items, e.g. buy 2 get 1
+type PrerequisiteToEntitlementQuantityRatio struct {
 	PrerequisiteQuantity int `json:"prerequisite_quantity,omitempty"`
 	EntitledQuantity     int `json:"entitled_quantity,omitempty"`
 }
@@ -96,7 +119,7 @@ func (pr *PriceRule) SetPrerequisiteSubtotalRange(greaterThanOrEqualTo *string)
 			return fmt.Errorf("failed to parse value as Decimal, invalid value")
 		}
 
-		pr.PrerequisiteSubtotalRange = &prerequisiteSubtotalRange{
+		pr.PrerequisiteSubtotalRange = &PrerequisiteSubtotalRange{
 			GreaterThanOrEqualTo: *greaterThanOrEqualTo,
 		}
 	}
@@ -109,7 +132,7 @@ func (pr *PriceRule) SetPrerequisiteQuantityRange(greaterThanOrEqualTo *int) {
 	if greaterThanOrEqualTo == nil {
 		pr.PrerequisiteQuantityRange = nil
 	} else {
-		pr.PrerequisiteQuantityRange = &prerequisiteQuantityRange{
+		pr.PrerequisiteQuantityRange = &PrerequisiteQuantityRange{
 			GreaterThanOrEqualTo: *greaterThanOrEqualTo,
 		}
 	}
@@ -124,7 +147,7 @@ func (pr *PriceRule) SetPrerequisiteShippingPriceRange(lessThanOrEqualTo *string
 			return fmt.Errorf("failed to parse value as Decimal, invalid value")
 		}
 
-		pr.PrerequisiteShippingPriceRange = &prerequisiteShippingPriceRange{
+		pr.PrerequisiteShippingPriceRange = &PrerequisiteShippingPriceRange{
 			LessThanOrEqualTo: *lessThanOrEqualTo,
 		}
 	}
@@ -148,7 +171,7 @@ func (pr *PriceRule) SetPrerequisiteToEntitlementQuantityRatio(prerequisiteQuant
 		eQuant = *entitledQuantity
 	}
 
-	pr.PrerequisiteToEntitlementQuantityRatio = &prerequisiteToEntitlementQuantityRatio{
+	pr.PrerequisiteToEntitlementQuantityRatio = &PrerequisiteToEntitlementQuantityRatio{
 		PrerequisiteQuantity: pQuant,
 		EntitledQuantity:     eQuant,
 	}
@@ -170,6 +193,34 @@ func (s *PriceRuleServiceOp) List() ([]PriceRule, error) {
 	return resource.PriceRules, err
 }
 
+// ListWithPagination retrieves a page of price rules, see PriceRuleListOptions
+func (s *PriceRuleServiceOp) ListWithPagination(options interface{}) ([]PriceRule, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", priceRulesBasePath)
+	resource := new(PriceRulesResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.PriceRules, pagination, nil
+}
+
+// Count counts price rules, see PriceRuleListOptions
+func (s *PriceRuleServiceOp) Count(options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", priceRulesBasePath)
+	return s.client.Count(path, options)
+}
+
 // Create creates a price rule
 func (s *PriceRuleServiceOp) Create(pr PriceRule) (*PriceRule, error) {
 	path := fmt.Sprintf("%s.json", priceRulesBasePath)
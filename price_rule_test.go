@@ -2,7 +2,10 @@ package goshopify
 
 import (
 	"fmt"
+	"net/http"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 )
@@ -215,3 +218,64 @@ func TestPriceRuleSetters(t *testing.T) {
 		t.Errorf("Failed to clear wholly prerequisite to entitlement quantity ratio")
 	}
 }
+
+func TestPriceRuleListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"limit": "1", "starts_at_min": "2020-01-01T00:00:00Z", "times_used": "0"}
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules.json", client.pathPrefix),
+		params,
+		httpmock.ResponderFromResponse(&http.Response{
+			StatusCode: 200,
+			Body:       httpmock.NewRespBodyFromBytes(loadFixture("price_rule/list.json")),
+			Header: http.Header{
+				"Link": {`<http://valid.url?limit=1&page_info=pageInfoCode>; rel="next"`},
+			},
+		}),
+	)
+
+	timesUsed := 0
+	options := PriceRuleListOptions{
+		ListOptions: ListOptions{Limit: 1},
+		StartsAtMin: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+		TimesUsed:   &timesUsed,
+	}
+	rules, page, err := client.PriceRule.ListWithPagination(options)
+	if err != nil {
+		t.Fatalf("PriceRule.ListWithPagination returned error: %v", err)
+	}
+
+	expected := []PriceRule{{ID: 1}}
+	if expected[0].ID != rules[0].ID {
+		t.Errorf("PriceRule.ListWithPagination returned %+v, expected %+v", rules, expected)
+	}
+
+	expectedPage := &ListOptions{PageInfo: "pageInfoCode", Limit: 1}
+	if page == nil || !reflect.DeepEqual(page.NextPageOptions, expectedPage) {
+		t.Errorf("PriceRule.ListWithPagination returned pagination %+v, expected next page %+v", page, expectedPage)
+	}
+}
+
+func TestPriceRuleCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 7}`),
+	)
+
+	cnt, err := client.PriceRule.Count(nil)
+	if err != nil {
+		t.Errorf("PriceRule.Count returned error: %v", err)
+	}
+
+	expected := 7
+	if cnt != expected {
+		t.Errorf("PriceRule.Count returned %d, expected %d", cnt, expected)
+	}
+}
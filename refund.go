@@ -9,11 +9,24 @@ import (
 	"github.com/shopspring/decimal"
 )
 
-const refundBasePath = "orders/%d/refund"
+const refundBasePath = "orders/%d/refunds"
 
+// Restock types of a refund line item.
+const (
+	RefundRestockTypeNoRestock = "no_restock"
+	RefundRestockTypeCancel    = "cancel"
+	RefundRestockTypeReturn    = "return"
+)
+
+// RefundService is an interface for interfacing with the refund endpoints of
+// the Shopify API.
+// See: https://shopify.dev/api/admin-rest/latest/resources/refund
 type RefundService interface {
 	List(int64, interface{}) ([]Refund, error)
 	ListWithPagination(int64, interface{}) ([]Refund, *Pagination, error)
+	Get(int64, int64, interface{}) (*Refund, error)
+	Calculate(int64, RefundCalculateOptions) (*RefundCalculation, error)
+	Create(int64, RefundCreateOptions) (*Refund, error)
 	GetOrderList() []string
 }
 
@@ -54,11 +67,246 @@ type RefundLineItem struct {
 	LineItem    *LineItem        `json:"line_item"`
 }
 
+// RefundShipping is the shipping part of a refund. Either FullRefund or
+// Amount is set on requests; calculations also return Tax and
+// MaximumRefundable.
+type RefundShipping struct {
+	FullRefund        bool             `json:"full_refund,omitempty"`
+	Amount            *decimal.Decimal `json:"amount,omitempty"`
+	Tax               *decimal.Decimal `json:"tax,omitempty"`
+	MaximumRefundable *decimal.Decimal `json:"maximum_refundable,omitempty"`
+}
+
+// RefundLineItemOptions selects a quantity of an order line item to refund.
+// LocationID is the location the items are restocked at, defaulting to the
+// one chosen by Shopify.
+type RefundLineItemOptions struct {
+	LineItemID  int64  `json:"line_item_id"`
+	Quantity    int    `json:"quantity"`
+	RestockType string `json:"restock_type,omitempty"`
+	LocationID  int64  `json:"location_id,omitempty"`
+}
+
+// RefundTransactionOptions is a refund transaction to create, refunding Amount
+// of the transaction ParentID.
+type RefundTransactionOptions struct {
+	ParentID int64            `json:"parent_id"`
+	Amount   *decimal.Decimal `json:"amount"`
+	Kind     string           `json:"kind"`
+	Gateway  string           `json:"gateway"`
+}
+
+// RefundCalculateOptions is used to calculate a refund.
+type RefundCalculateOptions struct {
+	Currency        string                  `json:"currency,omitempty"`
+	Shipping        *RefundShipping         `json:"shipping,omitempty"`
+	RefundLineItems []RefundLineItemOptions `json:"refund_line_items,omitempty"`
+}
+
+// RefundCreateOptions is used to create a refund.
+type RefundCreateOptions struct {
+	Currency          string                     `json:"currency,omitempty"`
+	Notify            bool                       `json:"notify,omitempty"`
+	Note              string                     `json:"note,omitempty"`
+	DiscrepancyReason string                     `json:"discrepancy_reason,omitempty"`
+	Shipping          *RefundShipping            `json:"shipping,omitempty"`
+	RefundLineItems   []RefundLineItemOptions    `json:"refund_line_items,omitempty"`
+	Transactions      []RefundTransactionOptions `json:"transactions,omitempty"`
+}
+
+// RefundSuggestedTransaction is a transaction suggested by a refund
+// calculation.
+type RefundSuggestedTransaction struct {
+	OrderID           int64            `json:"order_id"`
+	Kind              string           `json:"kind"`
+	Gateway           string           `json:"gateway"`
+	ParentID          int64            `json:"parent_id"`
+	Amount            *decimal.Decimal `json:"amount"`
+	Currency          string           `json:"currency"`
+	MaximumRefundable *decimal.Decimal `json:"maximum_refundable"`
+}
+
+// RefundCalculation is the result of calculating a refund.
+type RefundCalculation struct {
+	Currency        string                        `json:"currency"`
+	Shipping        *RefundShipping               `json:"shipping"`
+	RefundLineItems []*RefundLineItem             `json:"refund_line_items"`
+	Transactions    []*RefundSuggestedTransaction `json:"transactions"`
+}
+
+// CreateOptions returns the options to create the calculated refund, turning
+// the suggested transactions into refund transactions.
+func (c RefundCalculation) CreateOptions() RefundCreateOptions {
+	options := RefundCreateOptions{Currency: c.Currency}
+
+	if c.Shipping != nil && c.Shipping.Amount != nil && !c.Shipping.Amount.IsZero() {
+		options.Shipping = &RefundShipping{Amount: c.Shipping.Amount}
+	}
+
+	for _, item := range c.RefundLineItems {
+		options.RefundLineItems = append(options.RefundLineItems, RefundLineItemOptions{
+			LineItemID:  item.LineItemID,
+			Quantity:    item.Quantity,
+			RestockType: item.RestockType,
+			LocationID:  item.LocationID,
+		})
+	}
+
+	for _, transaction := range c.Transactions {
+		options.Transactions = append(options.Transactions, RefundTransactionOptions{
+			ParentID: transaction.ParentID,
+			Amount:   transaction.Amount,
+			Kind:     "refund",
+			Gateway:  transaction.Gateway,
+		})
+	}
+
+	return options
+}
+
+// NewFullRefundPlan returns the options to refund everything that has not been
+// refunded yet on an order: the remaining quantity of every line item, the
+// shipping, and the remaining amount of every successful sale or capture
+// transaction.
+//
+// The fulfilled quantity of a line item, taken from the successful
+// fulfillments of the order, is restocked as a return at the location it was
+// fulfilled from. The unfulfilled quantity is cancelled and restocked at
+// locationID, which is also used for returns when the order has no
+// fulfillment details.
+func NewFullRefundPlan(order Order, transactions []Transaction, locationID int64) RefundCreateOptions {
+	options := RefundCreateOptions{
+		Currency: order.Currency,
+		Shipping: &RefundShipping{FullRefund: true},
+	}
+
+	refunded := make(map[int64]int)
+	returned := make(map[int64]int)
+	for _, refund := range order.Refunds {
+		if refund == nil {
+			continue
+		}
+		for _, item := range refund.RefundLineItems {
+			if item == nil {
+				continue
+			}
+			refunded[item.LineItemID] += item.Quantity
+			if item.RestockType == RefundRestockTypeReturn {
+				returned[item.LineItemID] += item.Quantity
+			}
+		}
+	}
+
+	fulfilled := make(map[int64][]RefundLineItemOptions)
+	for _, fulfillment := range order.Fulfillments {
+		if fulfillment == nil || fulfillment.Status != "success" {
+			continue
+		}
+		for _, item := range fulfillment.LineItems {
+			if item == nil {
+				continue
+			}
+			fulfilled[item.ID] = append(fulfilled[item.ID], RefundLineItemOptions{
+				LineItemID: item.ID,
+				Quantity:   item.Quantity,
+				LocationID: fulfillment.LocationID,
+			})
+		}
+	}
+
+	for _, item := range order.LineItems {
+		if item == nil {
+			continue
+		}
+		quantity := item.Quantity - refunded[item.ID]
+		if quantity <= 0 {
+			continue
+		}
+
+		parts, ok := fulfilled[item.ID]
+		if !ok {
+			switch item.FulfillmentStatus {
+			case "fulfilled":
+				parts = []RefundLineItemOptions{{LineItemID: item.ID, Quantity: item.Quantity}}
+			case "partial":
+				parts = []RefundLineItemOptions{{LineItemID: item.ID, Quantity: item.Quantity - item.FulfillableQuantity}}
+			}
+		}
+
+		alreadyReturned := returned[item.ID]
+		for _, part := range parts {
+			if alreadyReturned >= part.Quantity {
+				alreadyReturned -= part.Quantity
+				continue
+			}
+			part.Quantity -= alreadyReturned
+			alreadyReturned = 0
+			if part.Quantity > quantity {
+				part.Quantity = quantity
+			}
+			if part.Quantity <= 0 {
+				break
+			}
+			if part.LocationID == 0 {
+				part.LocationID = locationID
+			}
+			part.RestockType = RefundRestockTypeReturn
+			options.RefundLineItems = append(options.RefundLineItems, part)
+			quantity -= part.Quantity
+		}
+
+		if quantity > 0 {
+			options.RefundLineItems = append(options.RefundLineItems, RefundLineItemOptions{
+				LineItemID:  item.ID,
+				Quantity:    quantity,
+				RestockType: RefundRestockTypeCancel,
+				LocationID:  locationID,
+			})
+		}
+	}
+
+	refundedAmounts := make(map[int64]decimal.Decimal)
+	for _, transaction := range transactions {
+		if transaction.Kind == "refund" && transaction.Status == "success" && transaction.ParentID != nil && transaction.Amount != nil {
+			refundedAmounts[*transaction.ParentID] = refundedAmounts[*transaction.ParentID].Add(*transaction.Amount)
+		}
+	}
+
+	for _, transaction := range transactions {
+		if transaction.Kind != "sale" && transaction.Kind != "capture" {
+			continue
+		}
+		if transaction.Status != "success" || transaction.Amount == nil {
+			continue
+		}
+		remaining := transaction.Amount.Sub(refundedAmounts[transaction.ID])
+		if !remaining.IsPositive() {
+			continue
+		}
+		options.Transactions = append(options.Transactions, RefundTransactionOptions{
+			ParentID: transaction.ID,
+			Amount:   &remaining,
+			Kind:     "refund",
+			Gateway:  transaction.Gateway,
+		})
+	}
+
+	return options
+}
+
+type RefundResource struct {
+	Refund *Refund `json:"refund"`
+}
+
 type RefundsResource struct {
 	Refunds []Refund `json:"refunds"`
 }
 
-// List of discount codes
+type RefundCalculationResource struct {
+	Refund *RefundCalculation `json:"refund"`
+}
+
+// List refunds of an order
 func (s *RefundServiceOp) List(orderID int64, options interface{}) ([]Refund, error) {
 	path := fmt.Sprintf(refundBasePath+".json", orderID)
 	resource := new(RefundsResource)
@@ -87,6 +335,32 @@ func (s *RefundServiceOp) ListWithPagination(orderID int64, options interface{})
 	return resource.Refunds, pagination, nil
 }
 
+// Get individual refund
+func (s *RefundServiceOp) Get(orderID int64, refundID int64, options interface{}) (*Refund, error) {
+	path := fmt.Sprintf(refundBasePath+"/%d.json", orderID, refundID)
+	resource := new(RefundResource)
+	err := s.client.Get(path, resource, options)
+	return resource.Refund, err
+}
+
+// Calculate the line items, shipping and transactions of a refund
+func (s *RefundServiceOp) Calculate(orderID int64, options RefundCalculateOptions) (*RefundCalculation, error) {
+	path := fmt.Sprintf(refundBasePath+"/calculate.json", orderID)
+	wrappedData := map[string]interface{}{"refund": options}
+	resource := new(RefundCalculationResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Refund, err
+}
+
+// Create a refund
+func (s *RefundServiceOp) Create(orderID int64, options RefundCreateOptions) (*Refund, error) {
+	path := fmt.Sprintf(refundBasePath+".json", orderID)
+	wrappedData := map[string]interface{}{"refund": options}
+	resource := new(RefundResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Refund, err
+}
+
 func (s *RefundServiceOp) GetOrderList() []string {
 	str := new(Refund)
 
@@ -0,0 +1,162 @@
+package goshopify
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func TestRefundGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds/509562969.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"refund":{"id":509562969,"order_id":450789469,"note":"it broke during shipping","refund_line_items":[{"id":104689539,"line_item_id":703073504,"quantity":1,"restock_type":"legacy_restock"}]}}`))
+
+	refund, err := client.Refund.Get(450789469, 509562969, nil)
+	if err != nil {
+		t.Fatalf("Refund.Get returned error: %v", err)
+	}
+
+	if refund.ID != 509562969 || refund.Note != "it broke during shipping" || len(refund.RefundLineItems) != 1 {
+		t.Errorf("Refund.Get returned %+v", refund)
+	}
+}
+
+func TestRefundCalculate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds/calculate.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			expected := `{"refund":{"shipping":{"full_refund":true},"refund_line_items":[{"line_item_id":518995019,"quantity":1,"restock_type":"no_restock"}]}}`
+			if string(body) != expected {
+				t.Errorf("Refund.Calculate sent %s, expected %s", body, expected)
+			}
+			return httpmock.NewStringResponse(200, `{"refund":{"currency":"USD","shipping":{"amount":"5.00","tax":"0.00","maximum_refundable":"5.00"},`+
+				`"refund_line_items":[{"quantity":1,"line_item_id":518995019,"location_id":null,"restock_type":"no_restock","price":"199.00","subtotal":"195.67","total_tax":"3.98"}],`+
+				`"transactions":[{"order_id":450789469,"kind":"suggested_refund","gateway":"bogus","parent_id":389404469,"amount":"204.65","currency":"USD","maximum_refundable":"209.00"}]}}`), nil
+		})
+
+	calculation, err := client.Refund.Calculate(450789469, RefundCalculateOptions{
+		Shipping:        &RefundShipping{FullRefund: true},
+		RefundLineItems: []RefundLineItemOptions{{LineItemID: 518995019, Quantity: 1, RestockType: RefundRestockTypeNoRestock}},
+	})
+	if err != nil {
+		t.Fatalf("Refund.Calculate returned error: %v", err)
+	}
+
+	amount := decimal.RequireFromString("204.65")
+	shipping := decimal.RequireFromString("5.00")
+	expected := RefundCreateOptions{
+		Currency:        "USD",
+		Shipping:        &RefundShipping{Amount: &shipping},
+		RefundLineItems: []RefundLineItemOptions{{LineItemID: 518995019, Quantity: 1, RestockType: RefundRestockTypeNoRestock}},
+		Transactions:    []RefundTransactionOptions{{ParentID: 389404469, Amount: &amount, Kind: "refund", Gateway: "bogus"}},
+	}
+	options := calculation.CreateOptions()
+	if !reflect.DeepEqual(options, expected) {
+		t.Errorf("RefundCalculation.CreateOptions returned %+v, expected %+v", options, expected)
+	}
+}
+
+func TestRefundCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds.json", client.pathPrefix),
+		httpmock.NewStringResponder(201, `{"refund":{"id":929361464,"order_id":450789469,"note":"wrong size"}}`))
+
+	amount := decimal.RequireFromString("41.94")
+	refund, err := client.Refund.Create(450789469, RefundCreateOptions{
+		Currency:     "USD",
+		Notify:       true,
+		Note:         "wrong size",
+		Transactions: []RefundTransactionOptions{{ParentID: 801038806, Amount: &amount, Kind: "refund", Gateway: "bogus"}},
+	})
+	if err != nil {
+		t.Fatalf("Refund.Create returned error: %v", err)
+	}
+
+	if refund.ID != 929361464 {
+		t.Errorf("Refund.ID returned %d, expected 929361464", refund.ID)
+	}
+}
+
+func TestNewFullRefundPlan(t *testing.T) {
+	parentID := int64(1)
+	amount := func(s string) *decimal.Decimal {
+		d := decimal.RequireFromString(s)
+		return &d
+	}
+
+	order := Order{
+		Currency: "USD",
+		LineItems: []*LineItem{
+			{ID: 10, Quantity: 2, FulfillmentStatus: "fulfilled"},
+			{ID: 11, Quantity: 1},
+			{ID: 12, Quantity: 1},
+		},
+		Refunds: []*Refund{
+			{RefundLineItems: []*RefundLineItem{{LineItemID: 12, Quantity: 1}}},
+		},
+	}
+	transactions := []Transaction{
+		{ID: 1, Kind: "sale", Status: "success", Amount: amount("30.00"), Gateway: "bogus"},
+		{ID: 2, Kind: "refund", Status: "success", Amount: amount("10.00"), ParentID: &parentID, Gateway: "bogus"},
+		{ID: 3, Kind: "sale", Status: "failure", Amount: amount("30.00"), Gateway: "bogus"},
+	}
+
+	plan := NewFullRefundPlan(order, transactions, 905684977)
+
+	expectedItems := []RefundLineItemOptions{
+		{LineItemID: 10, Quantity: 2, RestockType: RefundRestockTypeReturn, LocationID: 905684977},
+		{LineItemID: 11, Quantity: 1, RestockType: RefundRestockTypeCancel, LocationID: 905684977},
+	}
+	if !reflect.DeepEqual(plan.RefundLineItems, expectedItems) {
+		t.Errorf("NewFullRefundPlan line items = %+v, expected %+v", plan.RefundLineItems, expectedItems)
+	}
+
+	if len(plan.Transactions) != 1 || plan.Transactions[0].ParentID != 1 || !plan.Transactions[0].Amount.Equal(decimal.RequireFromString("20")) {
+		t.Errorf("NewFullRefundPlan transactions = %+v, expected 20.00 refunded from transaction 1", plan.Transactions)
+	}
+
+	if plan.Shipping == nil || !plan.Shipping.FullRefund {
+		t.Errorf("NewFullRefundPlan shipping = %+v, expected full refund", plan.Shipping)
+	}
+}
+
+func TestNewFullRefundPlanPartiallyFulfilled(t *testing.T) {
+	order := Order{
+		LineItems: []*LineItem{
+			{ID: 10, Quantity: 5, FulfillmentStatus: "partial"},
+			{ID: 11, Quantity: 2},
+		},
+		Fulfillments: []*Fulfillment{
+			{Status: "success", LocationID: 1, LineItems: []*LineItem{{ID: 10, Quantity: 2}}},
+			{Status: "success", LocationID: 2, LineItems: []*LineItem{{ID: 10, Quantity: 1}}},
+			{Status: "cancelled", LocationID: 3, LineItems: []*LineItem{{ID: 11, Quantity: 2}}},
+		},
+		Refunds: []*Refund{
+			{RefundLineItems: []*RefundLineItem{{LineItemID: 10, Quantity: 1, RestockType: RefundRestockTypeReturn}}},
+		},
+	}
+
+	plan := NewFullRefundPlan(order, nil, 905684977)
+
+	expectedItems := []RefundLineItemOptions{
+		{LineItemID: 10, Quantity: 1, RestockType: RefundRestockTypeReturn, LocationID: 1},
+		{LineItemID: 10, Quantity: 1, RestockType: RefundRestockTypeReturn, LocationID: 2},
+		{LineItemID: 10, Quantity: 2, RestockType: RefundRestockTypeCancel, LocationID: 905684977},
+		{LineItemID: 11, Quantity: 2, RestockType: RefundRestockTypeCancel, LocationID: 905684977},
+	}
+	if !reflect.DeepEqual(plan.RefundLineItems, expectedItems) {
+		t.Errorf("NewFullRefundPlan line items = %+v, expected %+v", plan.RefundLineItems, expectedItems)
+	}
+}
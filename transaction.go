@@ -18,6 +18,9 @@ type TransactionService interface {
 	Count(int64, interface{}) (int, error)
 	Get(int64, int64, interface{}) (*Transaction, error)
 	Create(int64, Transaction) (*Transaction, error)
+	Capture(int64, int64, decimal.Decimal) (*Transaction, error)
+	Void(int64, int64) (*Transaction, error)
+	Refund(int64, int64, decimal.Decimal) (*Transaction, error)
 	GetOrderList() []string
 }
 
@@ -114,6 +117,141 @@ func (s *TransactionServiceOp) Create(orderID int64, transaction Transaction) (*
 	return resource.Transaction, err
 }
 
+// transactionRequest is the payload of the capture, void and refund
+// transactions.
+type transactionRequest struct {
+	Kind     string           `json:"kind"`
+	ParentID int64            `json:"parent_id"`
+	Amount   *decimal.Decimal `json:"amount,omitempty"`
+	Currency string           `json:"currency,omitempty"`
+}
+
+// parentTransaction returns the parent transaction among an order's
+// transactions, along with the amount already processed by its successful
+// children of the given kinds.
+func (s *TransactionServiceOp) parentTransaction(orderID, parentID int64, childKinds ...string) (*Transaction, decimal.Decimal, error) {
+	processed := decimal.Zero
+
+	transactions, err := s.List(orderID, nil)
+	if err != nil {
+		return nil, processed, err
+	}
+
+	var parent *Transaction
+	for i, transaction := range transactions {
+		if transaction.ID == parentID {
+			parent = &transactions[i]
+			continue
+		}
+		if transaction.ParentID == nil || *transaction.ParentID != parentID || transaction.Status != "success" {
+			continue
+		}
+		for _, kind := range childKinds {
+			if transaction.Kind == kind && transaction.Amount != nil {
+				processed = processed.Add(*transaction.Amount)
+			}
+		}
+	}
+
+	if parent == nil {
+		return nil, processed, fmt.Errorf("transaction %d not found on order %d", parentID, orderID)
+	}
+	if parent.Status != "success" {
+		return nil, processed, fmt.Errorf("transaction %d has status %s, expected success", parentID, parent.Status)
+	}
+
+	return parent, processed, nil
+}
+
+// remainingAmount checks that amount is positive and does not exceed what is
+// left of the parent transaction once processed has been deducted.
+func remainingAmount(parent *Transaction, processed, amount decimal.Decimal) error {
+	if !amount.IsPositive() {
+		return fmt.Errorf("amount %s must be positive", amount)
+	}
+	if parent.Amount == nil {
+		return fmt.Errorf("transaction %d has no amount", parent.ID)
+	}
+	remaining := parent.Amount.Sub(processed)
+	if amount.GreaterThan(remaining) {
+		return fmt.Errorf("amount %s %s exceeds the remaining %s %s of transaction %d", amount, parent.Currency, remaining, parent.Currency, parent.ID)
+	}
+	return nil
+}
+
+// Capture an amount of an authorization. The amount is in the currency of the
+// authorization, which is the presentment currency of the order, and must not
+// exceed what has not been captured yet.
+func (s *TransactionServiceOp) Capture(orderID int64, parentID int64, amount decimal.Decimal) (*Transaction, error) {
+	parent, captured, err := s.parentTransaction(orderID, parentID, "capture")
+	if err != nil {
+		return nil, err
+	}
+	if parent.Kind != "authorization" {
+		return nil, fmt.Errorf("transaction %d is a %s, only authorizations can be captured", parentID, parent.Kind)
+	}
+	if err := remainingAmount(parent, captured, amount); err != nil {
+		return nil, err
+	}
+
+	return s.createTransaction(orderID, transactionRequest{
+		Kind:     "capture",
+		ParentID: parentID,
+		Amount:   &amount,
+		Currency: parent.Currency,
+	})
+}
+
+// Void an authorization that has not been captured
+func (s *TransactionServiceOp) Void(orderID int64, parentID int64) (*Transaction, error) {
+	parent, processed, err := s.parentTransaction(orderID, parentID, "capture", "void")
+	if err != nil {
+		return nil, err
+	}
+	if parent.Kind != "authorization" {
+		return nil, fmt.Errorf("transaction %d is a %s, only authorizations can be voided", parentID, parent.Kind)
+	}
+	if !processed.IsZero() {
+		return nil, fmt.Errorf("authorization %d has already been captured or voided", parentID)
+	}
+
+	return s.createTransaction(orderID, transactionRequest{
+		Kind:     "void",
+		ParentID: parentID,
+	})
+}
+
+// Refund an amount of a sale or capture. The amount is in the currency of the
+// parent transaction, which is the presentment currency of the order, and
+// must not exceed what has not been refunded yet.
+func (s *TransactionServiceOp) Refund(orderID int64, parentID int64, amount decimal.Decimal) (*Transaction, error) {
+	parent, refunded, err := s.parentTransaction(orderID, parentID, "refund")
+	if err != nil {
+		return nil, err
+	}
+	if parent.Kind != "sale" && parent.Kind != "capture" {
+		return nil, fmt.Errorf("transaction %d is a %s, only sales and captures can be refunded", parentID, parent.Kind)
+	}
+	if err := remainingAmount(parent, refunded, amount); err != nil {
+		return nil, err
+	}
+
+	return s.createTransaction(orderID, transactionRequest{
+		Kind:     "refund",
+		ParentID: parentID,
+		Amount:   &amount,
+		Currency: parent.Currency,
+	})
+}
+
+func (s *TransactionServiceOp) createTransaction(orderID int64, transaction transactionRequest) (*Transaction, error) {
+	path := fmt.Sprintf("%s/%d/transactions.json", ordersBasePath, orderID)
+	wrappedData := map[string]interface{}{"transaction": transaction}
+	resource := new(TransactionResource)
+	err := s.client.Post(path, wrappedData, resource)
+	return resource.Transaction, err
+}
+
 func (s *TransactionServiceOp) GetOrderList() []string {
 	str := new(Transaction)
 
@@ -2,6 +2,8 @@ package goshopify
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
 	"testing"
 	"time"
 
@@ -195,3 +197,123 @@ func TestTransactionCreate(t *testing.T) {
 	}
 	TransactionTests(t, *result)
 }
+
+// registerTransactionWorkflow registers the transactions of order 1: an
+// authorization of 409.94 EUR partially captured for 100.00.
+func registerTransactionWorkflow(t *testing.T, expectedBody string) {
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/1/transactions.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"transactions":[`+
+			`{"id":389404469,"order_id":1,"amount":"409.94","kind":"authorization","status":"success","currency":"EUR"},`+
+			`{"id":389404470,"order_id":1,"amount":"100.00","kind":"capture","status":"success","currency":"EUR","parent_id":389404469},`+
+			`{"id":389404471,"order_id":1,"amount":"300.00","kind":"capture","status":"failure","currency":"EUR","parent_id":389404469}]}`))
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/1/transactions.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			if string(body) != expectedBody {
+				t.Errorf("Transaction request body %s, expected %s", body, expectedBody)
+			}
+			return httpmock.NewStringResponse(201, `{"transaction":{"id":389404472,"order_id":1,"amount":"309.94","kind":"capture","status":"success","currency":"EUR","parent_id":389404469,`+
+				`"receipt":{"testcase":true,"authorization":"123456","paid_amount":"309.94"}}}`), nil
+		})
+}
+
+func TestTransactionCapture(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerTransactionWorkflow(t, `{"transaction":{"kind":"capture","parent_id":389404469,"amount":"309.94","currency":"EUR"}}`)
+
+	transaction, err := client.Transaction.Capture(1, 389404469, decimal.RequireFromString("309.94"))
+	if err != nil {
+		t.Fatalf("Transaction.Capture returned error: %v", err)
+	}
+
+	if transaction.Receipt == nil || transaction.Receipt.Authorization != "123456" || transaction.Receipt.Fields["paid_amount"] != "309.94" {
+		t.Errorf("Transaction.Receipt returned %+v", transaction.Receipt)
+	}
+
+	_, err = client.Transaction.Capture(1, 389404469, decimal.RequireFromString("309.95"))
+	if err == nil {
+		t.Errorf("Transaction.Capture of more than the remaining amount expected error")
+	}
+
+	_, err = client.Transaction.Capture(1, 389404470, decimal.RequireFromString("1.00"))
+	if err == nil {
+		t.Errorf("Transaction.Capture of a capture expected error")
+	}
+}
+
+func TestTransactionVoid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerTransactionWorkflow(t, "")
+
+	_, err := client.Transaction.Void(1, 389404469)
+	if err == nil {
+		t.Errorf("Transaction.Void of a captured authorization expected error")
+	}
+}
+
+func TestTransactionVoidAuthorization(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/2/transactions.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"transactions":[`+
+			`{"id":389404473,"order_id":2,"amount":"59.00","kind":"authorization","status":"success","currency":"EUR"},`+
+			`{"id":389404474,"order_id":2,"amount":"59.00","kind":"capture","status":"failure","currency":"EUR","parent_id":389404473}]}`))
+
+	expectedBody := `{"transaction":{"kind":"void","parent_id":389404473}}`
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/2/transactions.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			if string(body) != expectedBody {
+				t.Errorf("Transaction.Void request body %s, expected %s", body, expectedBody)
+			}
+			return httpmock.NewStringResponse(201, `{"transaction":{"id":389404475,"order_id":2,"amount":"59.00","kind":"void","status":"success","currency":"EUR","parent_id":389404473,`+
+				`"receipt":{"testcase":true,"authorization":"654321"}}}`), nil
+		})
+
+	transaction, err := client.Transaction.Void(2, 389404473)
+	if err != nil {
+		t.Fatalf("Transaction.Void returned error: %v", err)
+	}
+
+	if transaction.ID != 389404475 || transaction.Kind != "void" || transaction.Status != "success" {
+		t.Errorf("Transaction.Void returned %+v, expected a successful void", transaction)
+	}
+	if transaction.ParentID == nil || *transaction.ParentID != 389404473 {
+		t.Errorf("Transaction.ParentID returned %v, expected 389404473", transaction.ParentID)
+	}
+	if transaction.Receipt == nil || transaction.Receipt.Authorization != "654321" {
+		t.Errorf("Transaction.Receipt returned %+v, expected authorization 654321", transaction.Receipt)
+	}
+
+	if calls := httpmock.GetCallCountInfo()[fmt.Sprintf("POST https://fooshop.myshopify.com/%s/orders/2/transactions.json", client.pathPrefix)]; calls != 1 {
+		t.Errorf("Transaction.Void created %d transactions, expected 1", calls)
+	}
+}
+
+func TestTransactionRefund(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerTransactionWorkflow(t, `{"transaction":{"kind":"refund","parent_id":389404470,"amount":"50","currency":"EUR"}}`)
+
+	_, err := client.Transaction.Refund(1, 389404470, decimal.RequireFromString("50"))
+	if err != nil {
+		t.Errorf("Transaction.Refund returned error: %v", err)
+	}
+
+	_, err = client.Transaction.Refund(1, 389404470, decimal.RequireFromString("100.01"))
+	if err == nil {
+		t.Errorf("Transaction.Refund of more than the captured amount expected error")
+	}
+
+	_, err = client.Transaction.Refund(1, 999, decimal.RequireFromString("1"))
+	if err == nil {
+		t.Errorf("Transaction.Refund of an unknown transaction expected error")
+	}
+}
@@ -11,6 +11,20 @@ import (
 
 const transactionsBasePath = "shopify_payments/balance/transactions"
 
+// Types of a Shopify Payments balance transaction.
+const (
+	ShopifyPaymentsTransactionTypeCharge             = "charge"
+	ShopifyPaymentsTransactionTypeRefund             = "refund"
+	ShopifyPaymentsTransactionTypeDispute            = "dispute"
+	ShopifyPaymentsTransactionTypeReserve            = "reserve"
+	ShopifyPaymentsTransactionTypeAdjustment         = "adjustment"
+	ShopifyPaymentsTransactionTypeCredit             = "credit"
+	ShopifyPaymentsTransactionTypeDebit              = "debit"
+	ShopifyPaymentsTransactionTypePayout             = "payout"
+	ShopifyPaymentsTransactionTypePayoutFailure      = "payout_failure"
+	ShopifyPaymentsTransactionTypePayoutCancellation = "payout_cancellation"
+)
+
 type ShopifyPaymentsTransactionsService interface {
 	List(interface{}) ([]ShopifyPaymentsTransactions, error)
 	ListWithPagination(interface{}) ([]ShopifyPaymentsTransactions, *Pagination, error)
@@ -38,6 +52,16 @@ type ShopifyPaymentsTransactions struct {
 	ProcessedAt              *time.Time       `json:"processed_at"`
 }
 
+// ShopifyPaymentsTransactionsListOptions filters the balance transactions.
+// Only Limit and PageInfo may be set when requesting a next page.
+type ShopifyPaymentsTransactionsListOptions struct {
+	ListOptions
+	PayoutID     int64  `url:"payout_id,omitempty"`
+	PayoutStatus string `url:"payout_status,omitempty"`
+	LastID       int64  `url:"last_id,omitempty"`
+	Test         *bool  `url:"test,omitempty"`
+}
+
 type ShopifyPaymentsTransactionsResource struct {
 	ShopifyPaymentsTransactions []ShopifyPaymentsTransactions `json:"transactions"`
 }
@@ -2,7 +2,9 @@ package goshopify
 
 import (
 	"fmt"
+	"net/http"
 	"reflect"
+	"sort"
 	"time"
 )
 
@@ -18,6 +20,8 @@ type WebhookService interface {
 	Create(Webhook) (*Webhook, error)
 	Update(Webhook) (*Webhook, error)
 	Delete(int64) error
+	ListWithPagination(interface{}) ([]Webhook, *Pagination, error)
+	Reconcile([]Webhook, bool) (*WebhookPlan, error)
 	GetOrderList() []string
 }
 
@@ -45,6 +49,21 @@ type WebhookOptions struct {
 	Topic   string `url:"topic,omitempty"`
 }
 
+// WebhookPlan lists the changes needed to bring a shop's webhook
+// subscriptions in line with a desired set. Update holds the existing
+// webhooks with their ID kept and the desired address, format, fields and
+// metafield namespaces applied.
+type WebhookPlan struct {
+	Create []Webhook
+	Update []Webhook
+	Delete []Webhook
+}
+
+// Empty returns true if the plan contains no changes.
+func (p WebhookPlan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Update) == 0 && len(p.Delete) == 0
+}
+
 // WebhookResource represents the result from the admin/webhooks.json endpoint
 type WebhookResource struct {
 	Webhook *Webhook `json:"webhook"`
@@ -100,6 +119,167 @@ func (s *WebhookServiceOp) Delete(ID int64) error {
 	return s.client.Delete(fmt.Sprintf("%s/%d.json", webhooksBasePath, ID))
 }
 
+// ListWithPagination lists webhooks and return pagination to retrieve next/previous results.
+func (s *WebhookServiceOp) ListWithPagination(options interface{}) ([]Webhook, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", webhooksBasePath)
+	resource := new(WebhooksResource)
+	headers := http.Header{}
+
+	headers, err := s.client.createAndDoGetHeaders("GET", path, nil, options, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Extract pagination info from header
+	linkHeader := headers.Get("Link")
+
+	pagination, err := extractPagination(linkHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Webhooks, pagination, nil
+}
+
+// Reconcile brings the shop's webhook subscriptions in line with desired,
+// which must contain at most one webhook per topic. Existing webhooks for a
+// desired topic are updated in place, the remaining ones are deleted and
+// missing topics are created. When dryRun is true the plan is returned
+// without being applied.
+//
+// Deletes are applied first, then updates, then creates. A webhook that is
+// already gone when it is deleted is not treated as an error, so an
+// interrupted Reconcile can simply be run again.
+func (s *WebhookServiceOp) Reconcile(desired []Webhook, dryRun bool) (*WebhookPlan, error) {
+	var existing []Webhook
+	options := &ListOptions{Limit: 250}
+	for {
+		webhooks, pagination, err := s.ListWithPagination(options)
+		if err != nil {
+			return nil, err
+		}
+		existing = append(existing, webhooks...)
+		if pagination == nil || pagination.NextPageOptions == nil {
+			break
+		}
+		options = pagination.NextPageOptions
+	}
+
+	plan, err := NewWebhookPlan(existing, desired)
+	if err != nil || dryRun {
+		return plan, err
+	}
+
+	for _, webhook := range plan.Delete {
+		err := s.Delete(webhook.ID)
+		if respErr, ok := err.(ResponseError); ok && respErr.Status == http.StatusNotFound {
+			err = nil
+		}
+		if err != nil {
+			return plan, err
+		}
+	}
+
+	for _, webhook := range plan.Update {
+		if _, err := s.Update(webhook); err != nil {
+			return plan, err
+		}
+	}
+
+	for _, webhook := range plan.Create {
+		if _, err := s.Create(webhook); err != nil {
+			return plan, err
+		}
+	}
+
+	return plan, nil
+}
+
+// NewWebhookPlan computes the changes needed to go from the existing webhooks
+// to the desired ones. Webhooks are matched by topic; when several existing
+// webhooks share a topic the one with the desired address is kept.
+func NewWebhookPlan(existing, desired []Webhook) (*WebhookPlan, error) {
+	plan := new(WebhookPlan)
+
+	byTopic := make(map[string]Webhook, len(desired))
+	for _, webhook := range desired {
+		if webhook.Topic == "" {
+			return nil, fmt.Errorf("desired webhook for %s has no topic", webhook.Address)
+		}
+		if _, ok := byTopic[webhook.Topic]; ok {
+			return nil, fmt.Errorf("topic %s is desired more than once", webhook.Topic)
+		}
+		byTopic[webhook.Topic] = webhook
+	}
+
+	// pick the existing webhook to keep for each desired topic
+	kept := make(map[string]Webhook)
+	for _, webhook := range existing {
+		want, ok := byTopic[webhook.Topic]
+		if !ok {
+			continue
+		}
+		current, ok := kept[webhook.Topic]
+		if !ok || (current.Address != want.Address && webhook.Address == want.Address) {
+			kept[webhook.Topic] = webhook
+		}
+	}
+
+	for _, webhook := range existing {
+		if current, ok := kept[webhook.Topic]; !ok || current.ID != webhook.ID {
+			plan.Delete = append(plan.Delete, webhook)
+		}
+	}
+
+	for _, want := range desired {
+		current, ok := kept[want.Topic]
+		if !ok {
+			plan.Create = append(plan.Create, want)
+			continue
+		}
+		if !webhookMatches(current, want) {
+			want.ID = current.ID
+			want.CreatedAt = current.CreatedAt
+			want.UpdatedAt = current.UpdatedAt
+			plan.Update = append(plan.Update, want)
+		}
+	}
+
+	return plan, nil
+}
+
+// webhookMatches compares the updatable attributes of two webhooks.
+func webhookMatches(current, want Webhook) bool {
+	return current.Address == want.Address &&
+		webhookFormat(current.Format) == webhookFormat(want.Format) &&
+		stringSetsEqual(current.Fields, want.Fields) &&
+		stringSetsEqual(current.MetafieldNamespaces, want.MetafieldNamespaces)
+}
+
+// webhookFormat returns the format Shopify uses when none is set.
+func webhookFormat(format string) string {
+	if format == "" {
+		return "json"
+	}
+	return format
+}
+
+func stringSetsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (s *WebhookServiceOp) GetOrderList() []string {
 	str := new(Webhook)
 
@@ -0,0 +1,144 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+)
+
+// Mandatory compliance webhook topics every public app must handle.
+// See: https://shopify.dev/docs/apps/webhooks/configuration/mandatory-webhooks
+const (
+	CustomersDataRequestTopic = "customers/data_request"
+	CustomersRedactTopic      = "customers/redact"
+	ShopRedactTopic           = "shop/redact"
+)
+
+// ComplianceCustomer identifies the customer in a compliance webhook.
+type ComplianceCustomer struct {
+	ID    int64  `json:"id"`
+	Email string `json:"email"`
+	Phone string `json:"phone"`
+}
+
+// ComplianceDataRequest identifies a customers/data_request request.
+type ComplianceDataRequest struct {
+	ID int64 `json:"id"`
+}
+
+// CustomersDataRequestPayload is the payload of a customers/data_request
+// webhook, sent when a customer requests their data from a store owner.
+type CustomersDataRequestPayload struct {
+	ShopID          int64                 `json:"shop_id"`
+	ShopDomain      string                `json:"shop_domain"`
+	OrdersRequested []int64               `json:"orders_requested"`
+	Customer        ComplianceCustomer    `json:"customer"`
+	DataRequest     ComplianceDataRequest `json:"data_request"`
+}
+
+// CustomersRedactPayload is the payload of a customers/redact webhook, sent
+// when a store owner requests deletion of a customer's data.
+type CustomersRedactPayload struct {
+	ShopID         int64              `json:"shop_id"`
+	ShopDomain     string             `json:"shop_domain"`
+	Customer       ComplianceCustomer `json:"customer"`
+	OrdersToRedact []int64            `json:"orders_to_redact"`
+}
+
+// ShopRedactPayload is the payload of a shop/redact webhook, sent 48 hours
+// after a store owner uninstalls the app.
+type ShopRedactPayload struct {
+	ShopID     int64  `json:"shop_id"`
+	ShopDomain string `json:"shop_domain"`
+}
+
+// ComplianceHandler is an http.Handler for the mandatory compliance webhooks.
+// It verifies the HMAC signature with the App's ApiSecret and dispatches to
+// the callback matching the X-Shopify-Topic header.
+//
+// Shopify expects a 401 when the signature is invalid and a 2xx once the
+// request has been received; the data itself may be provided or deleted
+// later. The handler responds with:
+//   - 405 if the request is not a POST
+//   - 401 if the HMAC signature is missing or invalid
+//   - 400 if the topic is not a compliance topic or the body cannot be decoded
+//   - 500 if the callback returns an error or is not set
+//   - 200 otherwise
+type ComplianceHandler struct {
+	app App
+
+	CustomersDataRequest func(WebhookDelivery, *CustomersDataRequestPayload) error
+	CustomersRedact      func(WebhookDelivery, *CustomersRedactPayload) error
+	ShopRedact           func(WebhookDelivery, *ShopRedactPayload) error
+}
+
+// NewComplianceHandler returns a ComplianceHandler verifying requests with the
+// App's ApiSecret. The callbacks must be set before it serves requests.
+func (app App) NewComplianceHandler() *ComplianceHandler {
+	return &ComplianceHandler{app: app}
+}
+
+// ServeHTTP verifies and dispatches a compliance webhook request.
+func (h *ComplianceHandler) ServeHTTP(w http.ResponseWriter, httpRequest *http.Request) {
+	if httpRequest.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if ok, err := h.app.VerifyWebhookRequestVerbose(httpRequest); !ok || err != nil {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	delivery := NewWebhookDelivery(httpRequest)
+
+	var payload interface{}
+	var handle func() error
+	switch delivery.Topic {
+	case CustomersDataRequestTopic:
+		p := new(CustomersDataRequestPayload)
+		payload = p
+		if h.CustomersDataRequest != nil {
+			handle = func() error { return h.CustomersDataRequest(delivery, p) }
+		}
+	case CustomersRedactTopic:
+		p := new(CustomersRedactPayload)
+		payload = p
+		if h.CustomersRedact != nil {
+			handle = func() error { return h.CustomersRedact(delivery, p) }
+		}
+	case ShopRedactTopic:
+		p := new(ShopRedactPayload)
+		payload = p
+		if h.ShopRedact != nil {
+			handle = func() error { return h.ShopRedact(delivery, p) }
+		}
+	default:
+		http.Error(w, "unexpected topic "+delivery.Topic, http.StatusBadRequest)
+		return
+	}
+
+	body, err := ioutil.ReadAll(httpRequest.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := json.Unmarshal(body, payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if handle == nil {
+		http.Error(w, "no handler for topic "+delivery.Topic, http.StatusInternalServerError)
+		return
+	}
+
+	if err := handle(); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,64 @@
+package goshopify
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestComplianceHandler(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := app.NewComplianceHandler()
+
+	var dataRequest *CustomersDataRequestPayload
+	handler.CustomersDataRequest = func(d WebhookDelivery, p *CustomersDataRequestPayload) error {
+		dataRequest = p
+		return nil
+	}
+	handler.ShopRedact = func(WebhookDelivery, *ShopRedactPayload) error {
+		return errors.New("boom")
+	}
+
+	body := `{"shop_id":954889,"shop_domain":"fooshop.myshopify.com","orders_requested":[299938,280263],` +
+		`"customer":{"id":191167,"email":"john@example.com","phone":"555-625-1199"},"data_request":{"id":9999}}`
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, signedWebhookRequest(app.ApiSecret, CustomersDataRequestTopic, body))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("ComplianceHandler returned status %d, expected %d", rec.Code, http.StatusOK)
+	}
+
+	expected := &CustomersDataRequestPayload{
+		ShopID:          954889,
+		ShopDomain:      "fooshop.myshopify.com",
+		OrdersRequested: []int64{299938, 280263},
+		Customer:        ComplianceCustomer{ID: 191167, Email: "john@example.com", Phone: "555-625-1199"},
+		DataRequest:     ComplianceDataRequest{ID: 9999},
+	}
+	if !reflect.DeepEqual(dataRequest, expected) {
+		t.Errorf("ComplianceHandler decoded %+v, expected %+v", dataRequest, expected)
+	}
+
+	cases := []struct {
+		name     string
+		req      *http.Request
+		expected int
+	}{
+		{"bad signature", signedWebhookRequest("wrong", CustomersDataRequestTopic, body), http.StatusUnauthorized},
+		{"other topic", signedWebhookRequest(app.ApiSecret, "orders/create", `{"id":1}`), http.StatusBadRequest},
+		{"no callback", signedWebhookRequest(app.ApiSecret, CustomersRedactTopic, `{"shop_id":1}`), http.StatusInternalServerError},
+		{"callback error", signedWebhookRequest(app.ApiSecret, ShopRedactTopic, `{"shop_id":1}`), http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, c.req)
+		if rec.Code != c.expected {
+			t.Errorf("ComplianceHandler %s returned status %d, expected %d", c.name, rec.Code, c.expected)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package goshopify
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const defaultWebhookSeenStoreSize = 10000
+
+var (
+	// ErrWebhookDuplicate is returned when a delivery with the same
+	// X-Shopify-Webhook-Id has already been accepted.
+	ErrWebhookDuplicate = errors.New("webhook delivery already processed")
+
+	// ErrWebhookIDMissing is returned when a delivery has no
+	// X-Shopify-Webhook-Id header.
+	ErrWebhookIDMissing = fmt.Errorf("header %s not set", webhookIDHeader)
+
+	// ErrWebhookStale is returned when a delivery was triggered longer ago
+	// than the allowed maximum age.
+	ErrWebhookStale = errors.New("webhook delivery is stale")
+
+	// ErrWebhookTriggeredAtInvalid is returned when a maximum age is set and
+	// a delivery has no valid X-Shopify-Triggered-At header.
+	ErrWebhookTriggeredAtInvalid = fmt.Errorf("header %s missing or malformed", webhookTriggeredHeader)
+)
+
+// WebhookSeenStore records the X-Shopify-Webhook-Id of accepted deliveries.
+// Implementations must be safe for concurrent use; a shared store such as
+// Redis is needed when several processes receive webhooks for the same app.
+type WebhookSeenStore interface {
+	// Add records id and reports whether it had already been recorded.
+	// Checking and recording must happen atomically.
+	Add(id string) (bool, error)
+
+	// Remove forgets id so that a failed delivery can be processed again
+	// when Shopify retries it.
+	Remove(id string) error
+}
+
+// WebhookMemoryStore is an in-memory WebhookSeenStore that remembers the most
+// recently seen ids and evicts the least recently seen ones. Adding an id that
+// is already recorded counts as seeing it again.
+type WebhookMemoryStore struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List
+	ids   map[string]*list.Element
+}
+
+// NewWebhookMemoryStore returns a WebhookMemoryStore remembering up to size
+// ids.
+func NewWebhookMemoryStore(size int) *WebhookMemoryStore {
+	if size <= 0 {
+		size = defaultWebhookSeenStoreSize
+	}
+	return &WebhookMemoryStore{
+		size:  size,
+		order: list.New(),
+		ids:   make(map[string]*list.Element),
+	}
+}
+
+// Add records id and reports whether it had already been recorded.
+func (s *WebhookMemoryStore) Add(id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.ids[id]; ok {
+		s.order.MoveToFront(elem)
+		return true, nil
+	}
+
+	s.ids[id] = s.order.PushFront(id)
+	for s.order.Len() > s.size {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.ids, oldest.Value.(string))
+	}
+
+	return false, nil
+}
+
+// Remove forgets id.
+func (s *WebhookMemoryStore) Remove(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.ids[id]; ok {
+		s.order.Remove(elem)
+		delete(s.ids, id)
+	}
+
+	return nil
+}
+
+// WebhookDeduplicator verifies webhook requests and rejects deliveries that
+// were already accepted or that were triggered too long ago.
+type WebhookDeduplicator struct {
+	app    App
+	store  WebhookSeenStore
+	maxAge time.Duration
+	now    func() time.Time
+
+	// Log receives the errors that cannot be returned to Shopify, such as a
+	// failure to forget a delivery after the handler failed. It defaults to
+	// a logger printing errors to os.Stderr.
+	Log LeveledLoggerInterface
+}
+
+// NewWebhookDeduplicator returns a WebhookDeduplicator verifying requests with
+// the App's ApiSecret. A nil store defaults to an in-memory store. A maxAge of
+// zero disables the X-Shopify-Triggered-At check; otherwise deliveries without
+// a valid X-Shopify-Triggered-At are rejected.
+func (app App) NewWebhookDeduplicator(store WebhookSeenStore, maxAge time.Duration) *WebhookDeduplicator {
+	if store == nil {
+		store = NewWebhookMemoryStore(defaultWebhookSeenStoreSize)
+	}
+	return &WebhookDeduplicator{
+		app:    app,
+		store:  store,
+		maxAge: maxAge,
+		now:    time.Now,
+		Log:    &LeveledLogger{Level: LevelError},
+	}
+}
+
+// Verify checks the HMAC signature of a webhook request, rejects it with
+// ErrWebhookStale if it was triggered more than maxAge ago or with
+// ErrWebhookTriggeredAtInvalid if that cannot be told, and records its
+// X-Shopify-Webhook-Id, returning ErrWebhookDuplicate if it was already
+// recorded. The body of the request is still readable afterwards.
+func (d *WebhookDeduplicator) Verify(httpRequest *http.Request) (WebhookDelivery, error) {
+	delivery := NewWebhookDelivery(httpRequest)
+
+	ok, err := d.app.VerifyWebhookRequestVerbose(httpRequest)
+	if err != nil {
+		return delivery, err
+	}
+	if !ok {
+		return delivery, errors.New("invalid webhook signature")
+	}
+
+	return delivery, d.check(delivery)
+}
+
+// check applies the staleness and duplicate checks to a verified delivery.
+func (d *WebhookDeduplicator) check(delivery WebhookDelivery) error {
+	if delivery.WebhookID == "" {
+		return ErrWebhookIDMissing
+	}
+
+	if d.maxAge > 0 {
+		if delivery.TriggeredAt.IsZero() {
+			return ErrWebhookTriggeredAtInvalid
+		}
+		if d.now().Sub(delivery.TriggeredAt) > d.maxAge {
+			return ErrWebhookStale
+		}
+	}
+
+	seen, err := d.store.Add(delivery.WebhookID)
+	if err != nil {
+		return err
+	}
+	if seen {
+		return ErrWebhookDuplicate
+	}
+
+	return nil
+}
+
+// Forget removes a delivery from the store so that it can be accepted again.
+func (d *WebhookDeduplicator) Forget(delivery WebhookDelivery) error {
+	return d.store.Remove(delivery.WebhookID)
+}
+
+// Handler wraps next so that each delivery reaches it at most once.
+// Requests with an invalid signature are rejected with a 401, stale
+// deliveries and deliveries without an id or trigger time with a 400, and
+// duplicates are acknowledged with a 200 without calling next. When next
+// responds with anything but a 2xx or panics the delivery is forgotten so
+// that Shopify's retry is processed; an error forgetting it is sent to Log.
+// A panic is propagated after the delivery is forgotten.
+func (d *WebhookDeduplicator) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, httpRequest *http.Request) {
+		if ok, err := d.app.VerifyWebhookRequestVerbose(httpRequest); !ok || err != nil {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		delivery := NewWebhookDelivery(httpRequest)
+		switch err := d.check(delivery); err {
+		case nil:
+		case ErrWebhookDuplicate:
+			w.WriteHeader(http.StatusOK)
+			return
+		case ErrWebhookStale, ErrWebhookIDMissing, ErrWebhookTriggeredAtInvalid:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		default:
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		defer func() {
+			if p := recover(); p != nil {
+				d.forgetFailed(delivery)
+				panic(p)
+			}
+		}()
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, httpRequest)
+
+		if recorder.status < http.StatusOK || recorder.status >= http.StatusMultipleChoices {
+			d.forgetFailed(delivery)
+		}
+	})
+}
+
+// forgetFailed forgets a delivery that was not processed, sending any error
+// to Log since it cannot be returned to Shopify.
+func (d *WebhookDeduplicator) forgetFailed(delivery WebhookDelivery) {
+	if err := d.Forget(delivery); err != nil && d.Log != nil {
+		d.Log.Errorf("webhook %s could not be forgotten and its retry will be dropped: %v", delivery.WebhookID, err)
+	}
+}
+
+// statusRecorder remembers the status code written to a ResponseWriter.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,203 @@
+package goshopify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWebhookMemoryStore(t *testing.T) {
+	store := NewWebhookMemoryStore(2)
+
+	for _, id := range []string{"a", "b", "c"} {
+		if seen, _ := store.Add(id); seen {
+			t.Errorf("WebhookMemoryStore.Add(%s) returned true on first add", id)
+		}
+	}
+
+	if seen, _ := store.Add("c"); !seen {
+		t.Errorf("WebhookMemoryStore.Add(c) returned false on second add")
+	}
+
+	// "a" is the least recently seen id and was evicted
+	if seen, _ := store.Add("a"); seen {
+		t.Errorf("WebhookMemoryStore.Add(a) returned true after eviction")
+	}
+
+	store.Remove("a")
+	if seen, _ := store.Add("a"); seen {
+		t.Errorf("WebhookMemoryStore.Add(a) returned true after removal")
+	}
+}
+
+func TestWebhookMemoryStoreLeastRecentlySeen(t *testing.T) {
+	store := NewWebhookMemoryStore(2)
+
+	store.Add("a")
+	store.Add("b")
+
+	// seeing "a" again makes "b" the least recently seen id
+	if seen, _ := store.Add("a"); !seen {
+		t.Errorf("WebhookMemoryStore.Add(a) returned false on second add")
+	}
+	store.Add("c")
+
+	if seen, _ := store.Add("a"); !seen {
+		t.Errorf("WebhookMemoryStore.Add(a) returned false, expected b to be evicted instead")
+	}
+	if seen, _ := store.Add("b"); seen {
+		t.Errorf("WebhookMemoryStore.Add(b) returned true after eviction")
+	}
+}
+
+func TestWebhookDeduplicatorVerify(t *testing.T) {
+	setup()
+	defer teardown()
+
+	now := time.Date(2020, time.July, 1, 12, 0, 0, 0, time.UTC)
+	dedup := app.NewWebhookDeduplicator(nil, time.Hour)
+	dedup.now = func() time.Time { return now }
+
+	newReq := func(id string, triggeredAt time.Time) *http.Request {
+		req := signedWebhookRequest(app.ApiSecret, "orders/create", `{"id":1}`)
+		req.Header.Set("X-Shopify-Webhook-Id", id)
+		req.Header.Set("X-Shopify-Triggered-At", triggeredAt.Format(time.RFC3339Nano))
+		return req
+	}
+
+	cases := []struct {
+		req      *http.Request
+		expected error
+	}{
+		{newReq("1", now.Add(-time.Minute)), nil},
+		{newReq("1", now.Add(-time.Minute)), ErrWebhookDuplicate},
+		{newReq("2", now.Add(-2*time.Hour)), ErrWebhookStale},
+		{newReq("", now), ErrWebhookIDMissing},
+		{newReq("3", time.Time{}), ErrWebhookTriggeredAtInvalid},
+	}
+
+	for i, c := range cases {
+		_, err := dedup.Verify(c.req)
+		if err != c.expected {
+			t.Errorf("WebhookDeduplicator.Verify case %d returned %v, expected %v", i, err, c.expected)
+		}
+	}
+
+	for _, triggeredAt := range []string{"", "yesterday"} {
+		req := newReq("4", now)
+		req.Header.Set("X-Shopify-Triggered-At", triggeredAt)
+		if _, err := dedup.Verify(req); err != ErrWebhookTriggeredAtInvalid {
+			t.Errorf("WebhookDeduplicator.Verify with X-Shopify-Triggered-At %q returned %v, expected %v", triggeredAt, err, ErrWebhookTriggeredAtInvalid)
+		}
+	}
+
+	_, err := dedup.Verify(signedWebhookRequest("wrong", "orders/create", `{"id":1}`))
+	if err == nil {
+		t.Errorf("WebhookDeduplicator.Verify with a bad signature expected error")
+	}
+}
+
+func TestWebhookDeduplicatorHandler(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	status := http.StatusInternalServerError
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(status)
+	})
+	handler := app.NewWebhookDeduplicator(nil, 0).Handler(next)
+
+	// a failed delivery is processed again on retry
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, signedWebhookRequest(app.ApiSecret, "orders/create", `{"id":1}`))
+	if rec.Code != http.StatusInternalServerError || calls != 1 {
+		t.Fatalf("WebhookDeduplicator.Handler returned %d after %d calls, expected 500 after 1", rec.Code, calls)
+	}
+
+	status = http.StatusOK
+	for i := 0; i < 2; i++ {
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, signedWebhookRequest(app.ApiSecret, "orders/create", `{"id":1}`))
+		if rec.Code != http.StatusOK {
+			t.Errorf("WebhookDeduplicator.Handler returned %d, expected 200", rec.Code)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("WebhookDeduplicator.Handler called next %d times, expected 2", calls)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, signedWebhookRequest("wrong", "orders/create", `{"id":1}`))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("WebhookDeduplicator.Handler returned %d, expected 401", rec.Code)
+	}
+}
+
+type failingWebhookSeenStore struct {
+	WebhookSeenStore
+}
+
+func (s failingWebhookSeenStore) Remove(id string) error {
+	return errors.New("store unavailable")
+}
+
+type recordingLogger struct {
+	LeveledLogger
+	errors []string
+}
+
+func (l *recordingLogger) Errorf(format string, v ...interface{}) {
+	l.errors = append(l.errors, fmt.Sprintf(format, v...))
+}
+
+func TestWebhookDeduplicatorHandlerForgetError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	dedup := app.NewWebhookDeduplicator(failingWebhookSeenStore{NewWebhookMemoryStore(0)}, 0)
+	logger := new(recordingLogger)
+	dedup.Log = logger
+
+	rec := httptest.NewRecorder()
+	dedup.Handler(next).ServeHTTP(rec, signedWebhookRequest(app.ApiSecret, "orders/create", `{"id":1}`))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("WebhookDeduplicator.Handler returned %d, expected 500", rec.Code)
+	}
+
+	if len(logger.errors) != 1 || !strings.Contains(logger.errors[0], "store unavailable") {
+		t.Errorf("WebhookDeduplicator.Handler logged %v, expected the forget error", logger.errors)
+	}
+}
+
+func TestWebhookDeduplicatorHandlerPanic(t *testing.T) {
+	setup()
+	defer teardown()
+
+	store := NewWebhookMemoryStore(0)
+	dedup := app.NewWebhookDeduplicator(store, 0)
+	handler := dedup.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	func() {
+		defer func() {
+			if p := recover(); p != "boom" {
+				t.Errorf("WebhookDeduplicator.Handler recovered %v, expected the panic to propagate", p)
+			}
+		}()
+		handler.ServeHTTP(httptest.NewRecorder(), signedWebhookRequest(app.ApiSecret, "orders/create", `{"id":1}`))
+	}()
+
+	if seen, _ := store.Add("b54557e4-bdd9-4b37-8a5f-bf7d70bcd043"); seen {
+		t.Errorf("WebhookDeduplicator.Handler did not forget the delivery after a panic")
+	}
+}
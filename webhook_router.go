@@ -0,0 +1,298 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	webhookTopicHeader      = "X-Shopify-Topic"
+	webhookShopDomainHeader = "X-Shopify-Shop-Domain"
+	webhookIDHeader         = "X-Shopify-Webhook-Id"
+	webhookAPIVersionHeader = "X-Shopify-API-Version"
+	webhookTriggeredHeader  = "X-Shopify-Triggered-At"
+)
+
+// WebhookDelivery holds the metadata Shopify sends in the headers of every
+// webhook request.
+type WebhookDelivery struct {
+	Topic      string
+	ShopDomain string
+	WebhookID  string
+	APIVersion string
+
+	// TriggeredAt is the time the event occurred. It is zero if the header
+	// is missing or malformed.
+	TriggeredAt time.Time
+}
+
+// NewWebhookDelivery reads the X-Shopify-* headers of a webhook request.
+func NewWebhookDelivery(httpRequest *http.Request) WebhookDelivery {
+	triggeredAt, _ := time.Parse(time.RFC3339Nano, httpRequest.Header.Get(webhookTriggeredHeader))
+	return WebhookDelivery{
+		Topic:       httpRequest.Header.Get(webhookTopicHeader),
+		ShopDomain:  httpRequest.Header.Get(webhookShopDomainHeader),
+		WebhookID:   httpRequest.Header.Get(webhookIDHeader),
+		APIVersion:  httpRequest.Header.Get(webhookAPIVersionHeader),
+		TriggeredAt: triggeredAt,
+	}
+}
+
+// WebhookHandlerFunc handles a verified webhook. The payload is a pointer to
+// the struct the body was decoded into, e.g. *Order for orders/create.
+// Returning an error makes the router respond with a 500 so that Shopify
+// retries the delivery.
+type WebhookHandlerFunc func(delivery WebhookDelivery, payload interface{}) error
+
+type webhookRoute struct {
+	newPayload func() interface{}
+	handle     WebhookHandlerFunc
+}
+
+// WebhookRouter is an http.Handler that verifies incoming webhooks with the
+// App's ApiSecret and dispatches them to the handler registered for their
+// X-Shopify-Topic.
+//
+// The router responds with:
+//   - 405 if the request is not a POST
+//   - 401 if the HMAC signature is missing or invalid
+//   - 400 if the topic header is missing or the body cannot be decoded
+//   - 500 if the handler returns an error
+//   - 200 otherwise, including for topics without a registered handler
+//
+// Topics without a handler are acknowledged rather than rejected, since
+// Shopify removes a subscription whose deliveries keep failing.
+type WebhookRouter struct {
+	app    App
+	routes map[string]webhookRoute
+
+	// Fallback, when set, serves the verified deliveries of topics without a
+	// registered handler instead of acknowledging them with a 200.
+	Fallback http.Handler
+}
+
+// NewWebhookRouter returns a WebhookRouter verifying requests with the App's
+// ApiSecret.
+func (app App) NewWebhookRouter() *WebhookRouter {
+	return &WebhookRouter{
+		app:    app,
+		routes: make(map[string]webhookRoute),
+	}
+}
+
+// WebhookTopicPayload returns a pointer to a new value of the struct the
+// payload of the given topic decodes into, or nil if the topic is unknown.
+func WebhookTopicPayload(topic string) interface{} {
+	switch topic {
+	case "app/uninstalled":
+		return new(Shop)
+	case "order_transactions/create":
+		return new(Transaction)
+	case CustomersDataRequestTopic:
+		return new(CustomersDataRequestPayload)
+	case CustomersRedactTopic:
+		return new(CustomersRedactPayload)
+	case ShopRedactTopic:
+		return new(ShopRedactPayload)
+	}
+
+	resource := topic
+	if i := strings.Index(topic, "/"); i >= 0 {
+		resource = topic[:i]
+	}
+
+	switch resource {
+	case "orders":
+		return new(Order)
+	case "products":
+		return new(Product)
+	case "customers":
+		return new(Customer)
+	case "collections":
+		return new(Collection)
+	case "checkouts":
+		return new(Checkout)
+	case "draft_orders":
+		return new(DraftOrder)
+	case "fulfillments":
+		return new(Fulfillment)
+	case "fulfillment_events":
+		return new(FulfillmentEvent)
+	case "refunds":
+		return new(Refund)
+	case "inventory_items":
+		return new(InventoryItem)
+	case "inventory_levels":
+		return new(InventoryLevel)
+	case "locations":
+		return new(Location)
+	case "shop":
+		return new(Shop)
+	case "themes":
+		return new(Theme)
+	case "disputes":
+		return new(Dispute)
+	case "product_listings":
+		return new(ProductListing)
+	case "collection_listings":
+		return new(CollectionListing)
+	}
+
+	return nil
+}
+
+// Handle registers a handler for a topic. The body is decoded into the struct
+// returned by WebhookTopicPayload, or into a *json.RawMessage for topics it
+// does not know.
+func (r *WebhookRouter) Handle(topic string, fn WebhookHandlerFunc) {
+	newPayload := func() interface{} {
+		if payload := WebhookTopicPayload(topic); payload != nil {
+			return payload
+		}
+		return new(json.RawMessage)
+	}
+	r.routes[topic] = webhookRoute{newPayload: newPayload, handle: fn}
+}
+
+// HandleOrder registers a handler for an orders/* topic.
+func (r *WebhookRouter) HandleOrder(topic string, fn func(WebhookDelivery, *Order) error) {
+	r.routes[topic] = webhookRoute{
+		newPayload: func() interface{} { return new(Order) },
+		handle: func(d WebhookDelivery, p interface{}) error {
+			return fn(d, p.(*Order))
+		},
+	}
+}
+
+// HandleProduct registers a handler for a products/* topic.
+func (r *WebhookRouter) HandleProduct(topic string, fn func(WebhookDelivery, *Product) error) {
+	r.routes[topic] = webhookRoute{
+		newPayload: func() interface{} { return new(Product) },
+		handle: func(d WebhookDelivery, p interface{}) error {
+			return fn(d, p.(*Product))
+		},
+	}
+}
+
+// HandleCustomer registers a handler for a customers/* topic.
+func (r *WebhookRouter) HandleCustomer(topic string, fn func(WebhookDelivery, *Customer) error) {
+	r.routes[topic] = webhookRoute{
+		newPayload: func() interface{} { return new(Customer) },
+		handle: func(d WebhookDelivery, p interface{}) error {
+			return fn(d, p.(*Customer))
+		},
+	}
+}
+
+// HandleFulfillment registers a handler for a fulfillments/* topic.
+func (r *WebhookRouter) HandleFulfillment(topic string, fn func(WebhookDelivery, *Fulfillment) error) {
+	r.routes[topic] = webhookRoute{
+		newPayload: func() interface{} { return new(Fulfillment) },
+		handle: func(d WebhookDelivery, p interface{}) error {
+			return fn(d, p.(*Fulfillment))
+		},
+	}
+}
+
+// HandleRefund registers a handler for a refunds/* topic.
+func (r *WebhookRouter) HandleRefund(topic string, fn func(WebhookDelivery, *Refund) error) {
+	r.routes[topic] = webhookRoute{
+		newPayload: func() interface{} { return new(Refund) },
+		handle: func(d WebhookDelivery, p interface{}) error {
+			return fn(d, p.(*Refund))
+		},
+	}
+}
+
+// HandleCheckout registers a handler for a checkouts/* topic.
+func (r *WebhookRouter) HandleCheckout(topic string, fn func(WebhookDelivery, *Checkout) error) {
+	r.routes[topic] = webhookRoute{
+		newPayload: func() interface{} { return new(Checkout) },
+		handle: func(d WebhookDelivery, p interface{}) error {
+			return fn(d, p.(*Checkout))
+		},
+	}
+}
+
+// HandleDraftOrder registers a handler for a draft_orders/* topic.
+func (r *WebhookRouter) HandleDraftOrder(topic string, fn func(WebhookDelivery, *DraftOrder) error) {
+	r.routes[topic] = webhookRoute{
+		newPayload: func() interface{} { return new(DraftOrder) },
+		handle: func(d WebhookDelivery, p interface{}) error {
+			return fn(d, p.(*DraftOrder))
+		},
+	}
+}
+
+// HandleInventoryLevel registers a handler for an inventory_levels/* topic.
+func (r *WebhookRouter) HandleInventoryLevel(topic string, fn func(WebhookDelivery, *InventoryLevel) error) {
+	r.routes[topic] = webhookRoute{
+		newPayload: func() interface{} { return new(InventoryLevel) },
+		handle: func(d WebhookDelivery, p interface{}) error {
+			return fn(d, p.(*InventoryLevel))
+		},
+	}
+}
+
+// HandleShop registers a handler for the shop/update and app/uninstalled
+// topics.
+func (r *WebhookRouter) HandleShop(topic string, fn func(WebhookDelivery, *Shop) error) {
+	r.routes[topic] = webhookRoute{
+		newPayload: func() interface{} { return new(Shop) },
+		handle: func(d WebhookDelivery, p interface{}) error {
+			return fn(d, p.(*Shop))
+		},
+	}
+}
+
+// ServeHTTP verifies and dispatches a webhook request.
+func (r *WebhookRouter) ServeHTTP(w http.ResponseWriter, httpRequest *http.Request) {
+	if httpRequest.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if ok, err := r.app.VerifyWebhookRequestVerbose(httpRequest); !ok || err != nil {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	delivery := NewWebhookDelivery(httpRequest)
+	if delivery.Topic == "" {
+		http.Error(w, "header "+webhookTopicHeader+" not set", http.StatusBadRequest)
+		return
+	}
+
+	route, ok := r.routes[delivery.Topic]
+	if !ok {
+		if r.Fallback != nil {
+			r.Fallback.ServeHTTP(w, httpRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	body, err := ioutil.ReadAll(httpRequest.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	payload := route.newPayload()
+	if err := json.Unmarshal(body, payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := route.handle(delivery, payload); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
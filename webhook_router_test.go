@@ -0,0 +1,165 @@
+package goshopify
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func signedWebhookRequest(secret, topic, body string) *http.Request {
+	sender := App{ApiSecret: secret}.NewWebhookSender("fooshop")
+	sender.APIVersion = testApiVersion
+
+	req, err := sender.NewRequest("/webhooks", topic, []byte(body))
+	if err != nil {
+		panic(err)
+	}
+	req.Header.Set("X-Shopify-Webhook-Id", "b54557e4-bdd9-4b37-8a5f-bf7d70bcd043")
+	req.Header.Del("X-Shopify-Triggered-At")
+	return req
+}
+
+func TestWebhookRouterDispatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	router := app.NewWebhookRouter()
+
+	var gotOrder *Order
+	var gotDelivery WebhookDelivery
+	router.HandleOrder("orders/create", func(d WebhookDelivery, o *Order) error {
+		gotDelivery = d
+		gotOrder = o
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, signedWebhookRequest(app.ApiSecret, "orders/create", `{"id":123456,"email":"jon@doe.ca"}`))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("WebhookRouter returned status %d, expected %d", rec.Code, http.StatusOK)
+	}
+
+	if gotOrder == nil || gotOrder.ID != 123456 || gotOrder.Email != "jon@doe.ca" {
+		t.Errorf("WebhookRouter decoded order %+v, expected id 123456", gotOrder)
+	}
+
+	expected := WebhookDelivery{
+		Topic:      "orders/create",
+		ShopDomain: "fooshop.myshopify.com",
+		WebhookID:  "b54557e4-bdd9-4b37-8a5f-bf7d70bcd043",
+		APIVersion: testApiVersion,
+	}
+	if gotDelivery != expected {
+		t.Errorf("WebhookRouter delivery = %+v, expected %+v", gotDelivery, expected)
+	}
+}
+
+func TestWebhookRouterHandleGeneric(t *testing.T) {
+	setup()
+	defer teardown()
+
+	router := app.NewWebhookRouter()
+
+	var gotPayload interface{}
+	router.Handle("products/update", func(d WebhookDelivery, p interface{}) error {
+		gotPayload = p
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, signedWebhookRequest(app.ApiSecret, "products/update", `{"id":1,"title":"Soda"}`))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("WebhookRouter returned status %d, expected %d", rec.Code, http.StatusOK)
+	}
+
+	product, ok := gotPayload.(*Product)
+	if !ok {
+		t.Fatalf("WebhookRouter payload type %T, expected *Product", gotPayload)
+	}
+	if product.Title != "Soda" {
+		t.Errorf("Product.Title = %s, expected Soda", product.Title)
+	}
+}
+
+func TestWebhookRouterStatusCodes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	router := app.NewWebhookRouter()
+	router.HandleOrder("orders/create", func(WebhookDelivery, *Order) error {
+		return nil
+	})
+	router.HandleOrder("orders/paid", func(WebhookDelivery, *Order) error {
+		return errors.New("boom")
+	})
+
+	getReq := signedWebhookRequest(app.ApiSecret, "orders/create", `{"id":1}`)
+	getReq.Method = "GET"
+
+	noTopicReq := signedWebhookRequest(app.ApiSecret, "", `{"id":1}`)
+	noTopicReq.Header.Del("X-Shopify-Topic")
+
+	cases := []struct {
+		name     string
+		req      *http.Request
+		expected int
+	}{
+		{"method", getReq, http.StatusMethodNotAllowed},
+		{"bad signature", signedWebhookRequest("wrong", "orders/create", `{"id":1}`), http.StatusUnauthorized},
+		{"no topic", noTopicReq, http.StatusBadRequest},
+		{"unknown topic", signedWebhookRequest(app.ApiSecret, "products/create", `{"id":1}`), http.StatusOK},
+		{"bad body", signedWebhookRequest(app.ApiSecret, "orders/create", `{"id":"one"}`), http.StatusBadRequest},
+		{"handler error", signedWebhookRequest(app.ApiSecret, "orders/paid", `{"id":1}`), http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, c.req)
+		if rec.Code != c.expected {
+			t.Errorf("WebhookRouter %s returned status %d, expected %d", c.name, rec.Code, c.expected)
+		}
+	}
+}
+
+func TestWebhookRouterFallback(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var topics []string
+	router := app.NewWebhookRouter()
+	router.Fallback = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		topics = append(topics, r.Header.Get("X-Shopify-Topic")+" "+string(body))
+		w.WriteHeader(http.StatusAccepted)
+	})
+	router.HandleOrder("orders/create", func(WebhookDelivery, *Order) error {
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, signedWebhookRequest(app.ApiSecret, "products/create", `{"id":1}`))
+	if rec.Code != http.StatusAccepted {
+		t.Errorf("WebhookRouter fallback returned status %d, expected %d", rec.Code, http.StatusAccepted)
+	}
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, signedWebhookRequest(app.ApiSecret, "orders/create", `{"id":1}`))
+	if rec.Code != http.StatusOK {
+		t.Errorf("WebhookRouter returned status %d, expected %d", rec.Code, http.StatusOK)
+	}
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, signedWebhookRequest("wrong", "products/create", `{"id":1}`))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("WebhookRouter returned status %d for a bad signature, expected %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	if !reflect.DeepEqual(topics, []string{`products/create {"id":1}`}) {
+		t.Errorf("WebhookRouter fallback received %v, expected the products/create delivery", topics)
+	}
+}
@@ -0,0 +1,74 @@
+package goshopify
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestWebhookSenderNewRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	sender := app.NewWebhookSender("fooshop")
+	req, err := sender.NewRequest("https://example.com/webhooks", "orders/create", Order{ID: 123456})
+	if err != nil {
+		t.Fatalf("WebhookSender.NewRequest returned error: %v", err)
+	}
+
+	if ok, err := app.VerifyWebhookRequestVerbose(req); !ok || err != nil {
+		t.Errorf("WebhookSender.NewRequest is not verified: %v", err)
+	}
+
+	delivery := NewWebhookDelivery(req)
+	if delivery.Topic != "orders/create" || delivery.ShopDomain != "fooshop.myshopify.com" {
+		t.Errorf("WebhookSender.NewRequest delivery = %+v", delivery)
+	}
+
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	if !uuid.MatchString(delivery.WebhookID) {
+		t.Errorf("WebhookSender.NewRequest webhook id %s is not a UUID", delivery.WebhookID)
+	}
+
+	if time.Since(delivery.TriggeredAt) > time.Minute {
+		t.Errorf("WebhookSender.NewRequest triggered at %v", delivery.TriggeredAt)
+	}
+}
+
+func TestWebhookSenderSendFile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	router := app.NewWebhookRouter()
+	handled := false
+	router.Handle("webhooks/test", func(d WebhookDelivery, p interface{}) error {
+		handled = true
+		return nil
+	})
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	sender := app.NewWebhookSender("fooshop")
+	req, err := sender.NewRequestFromFile(server.URL, "webhooks/test", "fixtures/webhook.json")
+	if err != nil {
+		t.Fatalf("WebhookSender.NewRequestFromFile returned error: %v", err)
+	}
+	expected, _ := ioutil.ReadFile("fixtures/webhook.json")
+	body, _ := ioutil.ReadAll(req.Body)
+	if string(body) != string(expected) {
+		t.Errorf("WebhookSender.NewRequestFromFile body = %s, expected fixture content", body)
+	}
+
+	resp, err := sender.Send(server.URL, "webhooks/test", expected)
+	if err != nil {
+		t.Fatalf("WebhookSender.Send returned error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || !handled {
+		t.Errorf("WebhookSender.Send returned status %d, expected %d", resp.StatusCode, http.StatusOK)
+	}
+}
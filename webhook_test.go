@@ -161,3 +161,88 @@ func TestWebhookDelete(t *testing.T) {
 		t.Errorf("Webhook.Delete returned error: %v", err)
 	}
 }
+
+func TestNewWebhookPlan(t *testing.T) {
+	existing := []Webhook{
+		{ID: 1, Topic: "orders/create", Address: "https://example.com/orders", Format: "json"},
+		{ID: 2, Topic: "orders/create", Address: "https://old.example.com/orders", Format: "json"},
+		{ID: 3, Topic: "products/update", Address: "https://example.com/products", Format: "json", Fields: []string{"id", "title"}},
+		{ID: 4, Topic: "customers/create", Address: "https://example.com/customers", Format: "json"},
+	}
+	desired := []Webhook{
+		{Topic: "orders/create", Address: "https://example.com/orders"},
+		{Topic: "products/update", Address: "https://example.com/products", Fields: []string{"title", "id", "vendor"}},
+		{Topic: "app/uninstalled", Address: "https://example.com/uninstalled"},
+	}
+
+	plan, err := NewWebhookPlan(existing, desired)
+	if err != nil {
+		t.Fatalf("NewWebhookPlan returned error: %v", err)
+	}
+
+	if len(plan.Create) != 1 || plan.Create[0].Topic != "app/uninstalled" {
+		t.Errorf("WebhookPlan.Create = %+v, expected app/uninstalled", plan.Create)
+	}
+
+	if len(plan.Update) != 1 || plan.Update[0].ID != 3 {
+		t.Errorf("WebhookPlan.Update = %+v, expected webhook 3", plan.Update)
+	}
+
+	var deleted []int64
+	for _, w := range plan.Delete {
+		deleted = append(deleted, w.ID)
+	}
+	if !reflect.DeepEqual(deleted, []int64{2, 4}) {
+		t.Errorf("WebhookPlan.Delete = %v, expected [2 4]", deleted)
+	}
+
+	plan, err = NewWebhookPlan(existing[:1], desired[:1])
+	if err != nil {
+		t.Fatalf("NewWebhookPlan returned error: %v", err)
+	}
+	if !plan.Empty() {
+		t.Errorf("WebhookPlan = %+v, expected empty plan", plan)
+	}
+
+	_, err = NewWebhookPlan(nil, []Webhook{desired[0], desired[0]})
+	if err == nil {
+		t.Errorf("NewWebhookPlan with a duplicate topic expected error")
+	}
+}
+
+func TestWebhookReconcile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("webhooks.json")))
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks/4759306.json", client.pathPrefix),
+		httpmock.NewStringResponder(404, `{"errors":"Not Found"}`))
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("webhook.json")))
+
+	desired := []Webhook{{Topic: "orders/paid", Address: "http://apple.com"}}
+
+	plan, err := client.Webhook.Reconcile(desired, true)
+	if err != nil {
+		t.Fatalf("Webhook.Reconcile returned error: %v", err)
+	}
+	if len(plan.Create) != 1 || len(plan.Delete) != 1 || len(plan.Update) != 0 {
+		t.Errorf("Webhook.Reconcile returned plan %+v, expected 1 create and 1 delete", plan)
+	}
+
+	info := httpmock.GetCallCountInfo()
+	if info["POST "+fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks.json", client.pathPrefix)] != 0 {
+		t.Errorf("Webhook.Reconcile with dry run created webhooks")
+	}
+
+	_, err = client.Webhook.Reconcile(desired, false)
+	if err != nil {
+		t.Fatalf("Webhook.Reconcile returned error: %v", err)
+	}
+
+	info = httpmock.GetCallCountInfo()
+	if info["POST "+fmt.Sprintf("https://fooshop.myshopify.com/%s/webhooks.json", client.pathPrefix)] != 1 {
+		t.Errorf("Webhook.Reconcile expected one create call, got %v", info)
+	}
+}